@@ -0,0 +1,11 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_branch" "release" {
+  owner         = "lerentis"
+  repo          = gitea_repository.test.name
+  name          = "release/1.0"
+  source_branch = "main"
+}
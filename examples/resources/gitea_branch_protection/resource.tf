@@ -0,0 +1,45 @@
+resource "gitea_org" "test_org" {
+  name = "test-org"
+}
+
+resource "gitea_repository" "test" {
+  username = gitea_org.test_org.name
+  name     = "test"
+}
+
+resource "gitea_team" "maintainers" {
+  name         = "Maintainers"
+  organisation = gitea_org.test_org.name
+  permission   = "write"
+}
+
+resource "gitea_branch_protection" "main" {
+  owner  = gitea_org.test_org.name
+  repo   = gitea_repository.test.name
+  branch = "main"
+
+  enable_push           = true
+  enable_push_whitelist = true
+  push_whitelist_teams  = [gitea_team.maintainers.name]
+
+  enable_status_check   = true
+  status_check_contexts = ["ci/woodpecker/push/build"]
+
+  required_approvals        = 1
+  block_on_rejected_reviews = true
+  dismiss_stale_approvals   = true
+
+  protected_file_patterns = ".gitea/*;CODEOWNERS"
+}
+
+resource "gitea_branch_protection" "releases" {
+  owner     = gitea_org.test_org.name
+  repo      = gitea_repository.test.name
+  branch    = "release/*"
+  rule_name = "release/*"
+
+  enable_push               = true
+  enable_push_whitelist     = true
+  push_whitelist_teams      = [gitea_team.maintainers.name]
+  unprotected_file_patterns = "CHANGELOG.md"
+}
@@ -0,0 +1,11 @@
+resource "gitea_org" "test_org" {
+  name = "test-org"
+}
+
+resource "gitea_org_webhook" "ci" {
+  org    = gitea_org.test_org.name
+  type   = "gitea"
+  url    = "https://ci.example.com/hook"
+  secret = var.webhook_secret
+  events = ["push", "create", "delete", "pull_request"]
+}
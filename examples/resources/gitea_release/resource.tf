@@ -0,0 +1,13 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_release" "v1" {
+  owner    = "lerentis"
+  repo     = gitea_repository.test.name
+  tag_name = "v1.0.0"
+  target   = "main"
+  title    = "v1.0.0"
+  body     = "First stable release"
+}
@@ -0,0 +1,13 @@
+resource "gitea_release" "v1" {
+  owner    = "lerentis"
+  repo     = "test"
+  tag_name = "v1.0.0"
+  title    = "v1.0.0"
+}
+
+resource "gitea_release_attachment" "binary" {
+  owner      = gitea_release.v1.owner
+  repo       = gitea_release.v1.repo
+  release_id = gitea_release.v1.release_id
+  source     = "${path.module}/dist/app_linux_amd64.tar.gz"
+}
@@ -5,6 +5,7 @@ resource "gitea_repository" "test" {
   issue_labels = "Default"
   license      = "MIT"
   gitignores   = "Go"
+  topics       = ["go", "terraform"]
 }
 
 resource "gitea_repository" "mirror" {
@@ -26,3 +27,48 @@ resource "gitea_repository" "clone" {
   migration_service            = "gitea"
   migration_service_auth_token = var.gitea_clone_token
 }
+
+resource "gitea_repository" "golden" {
+  username      = "lerentis"
+  name          = "service-template"
+  repo_template = true
+}
+
+resource "gitea_repository" "service" {
+  username        = "lerentis"
+  name            = "my-service"
+  template_owner  = "lerentis"
+  template_repo   = gitea_repository.golden.name
+  template_labels = true
+}
+
+resource "gitea_repository" "jira" {
+  username = "lerentis"
+  name     = "jira-tracked"
+
+  external_tracker {
+    url    = "https://jira.example.com"
+    format = "https://jira.example.com/browse/{index}"
+    style  = "alphanumeric"
+  }
+
+  external_wiki {
+    url = "https://confluence.example.com"
+  }
+}
+
+resource "gitea_repository" "squash_only" {
+  username              = "lerentis"
+  name                  = "squash-only"
+  allow_merge_commits   = false
+  allow_rebase          = false
+  allow_rebase_explicit = false
+  allow_squash_merge    = true
+  default_merge_style   = "squash"
+}
+
+resource "gitea_repository" "keep_on_destroy" {
+  username         = "lerentis"
+  name             = "keep-on-destroy"
+  destroy_behavior = "archive"
+}
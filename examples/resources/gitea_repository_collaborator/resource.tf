@@ -0,0 +1,19 @@
+resource "gitea_user" "contractor" {
+  username             = "contractor"
+  login_name           = "contractor"
+  password             = "Geheim1!"
+  email                = "contractor@user.dev"
+  must_change_password = false
+}
+
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_repository_collaborator" "contractor" {
+  owner      = "lerentis"
+  repo       = gitea_repository.test.name
+  username   = gitea_user.contractor.username
+  permission = "read"
+}
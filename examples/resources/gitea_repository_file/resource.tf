@@ -0,0 +1,15 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_repository_file" "codeowners" {
+  owner               = "lerentis"
+  repo                = gitea_repository.test.name
+  path                = "CODEOWNERS"
+  content             = "* @lerentis\n"
+  commit_message      = "Manage CODEOWNERS with terraform"
+  author_name         = "Terraform"
+  author_email        = "terraform@example.com"
+  overwrite_on_create = true
+}
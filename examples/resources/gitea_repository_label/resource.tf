@@ -0,0 +1,12 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_repository_label" "bug" {
+  owner       = "lerentis"
+  repo        = gitea_repository.test.name
+  name        = "kind/bug"
+  color       = "#ee0701"
+  description = "Something is not working"
+}
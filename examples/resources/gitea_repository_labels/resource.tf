@@ -0,0 +1,20 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_repository_labels" "test" {
+  owner = "lerentis"
+  repo  = gitea_repository.test.name
+
+  label {
+    name  = "priority/high"
+    color = "#b60205"
+  }
+
+  label {
+    name        = "kind/bug"
+    color       = "#ee0701"
+    description = "Something is not working"
+  }
+}
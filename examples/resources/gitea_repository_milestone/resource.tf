@@ -0,0 +1,12 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_repository_milestone" "q1" {
+  owner       = "lerentis"
+  repo        = gitea_repository.test.name
+  title       = "2023-Q1"
+  description = "Planning for the first quarter"
+  due_date    = "2023-03-31T23:59:59Z"
+}
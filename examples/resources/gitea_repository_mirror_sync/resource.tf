@@ -0,0 +1,16 @@
+resource "gitea_repository" "mirror" {
+  username                = "lerentis"
+  name                    = "terraform-provider-gitea-mirror"
+  mirror                  = true
+  migration_clone_address = "https://git.uploadfilter24.eu/lerentis/terraform-provider-gitea.git"
+  migration_service       = "gitea"
+}
+
+resource "gitea_repository_mirror_sync" "mirror" {
+  owner = gitea_repository.mirror.username
+  repo  = gitea_repository.mirror.name
+
+  triggers = {
+    upstream_release = var.upstream_release
+  }
+}
@@ -0,0 +1,21 @@
+resource "gitea_org" "test_org" {
+  name = "test-org"
+}
+
+resource "gitea_team" "test_team" {
+  name                     = "Devs"
+  organisation             = gitea_org.test_org.name
+  permission               = "write"
+  include_all_repositories = false
+}
+
+resource "gitea_repository" "test" {
+  username = gitea_org.test_org.name
+  name     = "test"
+}
+
+resource "gitea_repository_team" "test" {
+  owner = gitea_org.test_org.name
+  repo  = gitea_repository.test.name
+  team  = gitea_team.test_team.name
+}
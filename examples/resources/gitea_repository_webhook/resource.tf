@@ -0,0 +1,27 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_repository_webhook" "ci" {
+  owner         = "lerentis"
+  repo          = gitea_repository.test.name
+  type          = "gitea"
+  url           = "https://ci.example.com/hook"
+  secret        = var.webhook_secret
+  events        = ["push", "pull_request"]
+  branch_filter = "main"
+}
+
+resource "gitea_repository_webhook" "chat" {
+  owner  = "lerentis"
+  repo   = gitea_repository.test.name
+  type   = "slack"
+  url    = "https://hooks.slack.com/services/T000/B000/XXXX"
+  events = ["release"]
+
+  config = {
+    channel  = "#releases"
+    username = "gitea"
+  }
+}
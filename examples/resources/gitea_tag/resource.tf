@@ -0,0 +1,12 @@
+resource "gitea_repository" "test" {
+  username = "lerentis"
+  name     = "test"
+}
+
+resource "gitea_tag" "production" {
+  owner   = "lerentis"
+  repo    = gitea_repository.test.name
+  name    = "production-2023-03-01"
+  target  = "main"
+  message = "Promoted to production"
+}
@@ -39,3 +39,15 @@ resource "gitea_team" "test_team_restricted" {
   include_all_repositories = false
   repositories             = [gitea_repository.test.name]
 }
+
+resource "gitea_team" "test_team_reporters" {
+  name         = "Reporters"
+  organisation = gitea_org.test_org.name
+  description  = "Can read the code and work on issues"
+  permission   = "read"
+  units        = ["repo.code", "repo.issues"]
+  units_map = {
+    "repo.code"   = "read"
+    "repo.issues" = "write"
+  }
+}
@@ -0,0 +1,15 @@
+resource "gitea_org" "test_org" {
+  name = "test-org"
+}
+
+resource "gitea_team" "developers" {
+  name           = "Developers"
+  organisation   = gitea_org.test_org.name
+  permission     = "write"
+  ignore_members = true
+}
+
+resource "gitea_team_membership" "alice" {
+  team_id  = gitea_team.developers.id
+  username = "alice"
+}
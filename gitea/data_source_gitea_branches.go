@@ -0,0 +1,101 @@
+package gitea
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGiteaBranches() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaBranchesRead,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			"repo": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository",
+			},
+			"branches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+				Description: "All branches of the repository",
+			},
+		},
+		Description: "`gitea_branches` lists all branches of a repository",
+	}
+}
+
+func getAllRepoBranches(c *gitea.Client, owner string, repo string) (branches []*gitea.Branch, err error) {
+	page := 1
+
+	for {
+		branchBuffer, _, err := c.ListRepoBranches(owner, repo, gitea.ListRepoBranchesOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(branchBuffer) == 0 {
+			return branches, nil
+		}
+
+		branches = append(branches, branchBuffer...)
+
+		page += 1
+	}
+}
+
+func dataSourceGiteaBranchesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repo").(string)
+
+	branches, err := getAllRepoBranches(client, owner, repo)
+	if err != nil {
+		return err
+	}
+
+	var result []map[string]interface{}
+	for _, branch := range branches {
+		var sha string
+		if branch.Commit != nil {
+			sha = branch.Commit.ID
+		}
+		result = append(result, map[string]interface{}{
+			"name":       branch.Name,
+			"commit_sha": sha,
+			"protected":  branch.Protected,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repo))
+	return d.Set("branches", result)
+}
@@ -103,6 +103,13 @@ func dataSourceGiteaRepo() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"topics": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
 		},
 	}
 }
@@ -150,5 +157,12 @@ func dataSourceGiteaRepoRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("permission_admin", repo.Permissions.Admin)
 	d.Set("permission_push", repo.Permissions.Push)
 	d.Set("permission_pull", repo.Permissions.Pull)
+
+	topics, err := getAllRepoTopics(client, repo.Owner.UserName, repo.Name)
+	if err != nil {
+		return err
+	}
+	d.Set("topics", schema.NewSet(schema.HashString, CollapseStringList(topics)))
+
 	return nil
 }
@@ -1 +1,228 @@
 package gitea
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// the attributes of every repository are the same as the ones of gitea_repo
+func dataSourceGiteaReposElem() *schema.Resource {
+	attributes := dataSourceGiteaRepo().Schema
+	for _, attribute := range attributes {
+		attribute.Required = false
+		attribute.ForceNew = false
+		attribute.Computed = true
+	}
+	attributes["id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+
+	return &schema.Resource{Schema: attributes}
+}
+
+func dataSourceGiteaRepos() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaReposRead,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list repositories of this user or organisation",
+			},
+			"keyword": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"topic"},
+				Description:   "Only list repositories whose name contains the keyword",
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list repositories with this topic",
+			},
+			"private": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list private (`true`) or public (`false`) repositories",
+			},
+			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list archived (`true`) or active (`false`) repositories",
+			},
+			"mirror": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list mirrors (`true`) or no mirrors (`false`)",
+			},
+			"template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list template repositories (`true`) or no templates (`false`)",
+			},
+			"fork": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list forks (`true`) or no forks (`false`)",
+			},
+			"repos": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dataSourceGiteaReposElem(),
+				Description: "The matching repositories with the same attributes as the `gitea_repo` data source",
+			},
+		},
+		Description: "`gitea_repos` lists all repositories visible to the provider matching the given filters.\n\n" +
+			"Filters that are not set are ignored.",
+	}
+}
+
+func searchAllRepos(c *gitea.Client, opt gitea.SearchRepoOptions) (repos []*gitea.Repository, err error) {
+	page := 1
+
+	for {
+		opt.ListOptions = gitea.ListOptions{
+			Page:     page,
+			PageSize: 50,
+		}
+		repoBuffer, _, err := c.SearchRepos(opt)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(repoBuffer) == 0 {
+			return repos, nil
+		}
+
+		repos = append(repos, repoBuffer...)
+
+		page += 1
+	}
+}
+
+func getAllOwnerRepos(c *gitea.Client, owner string) (repos []*gitea.Repository, err error) {
+	_, resp, err := c.GetOrg(owner)
+	orgRepos := err == nil
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return nil, err
+	}
+
+	page := 1
+
+	for {
+		listOptions := gitea.ListOptions{
+			Page:     page,
+			PageSize: 50,
+		}
+
+		var repoBuffer []*gitea.Repository
+		if orgRepos {
+			repoBuffer, _, err = c.ListOrgRepos(owner, gitea.ListOrgReposOptions{ListOptions: listOptions})
+		} else {
+			repoBuffer, _, err = c.ListUserRepos(owner, gitea.ListReposOptions{ListOptions: listOptions})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(repoBuffer) == 0 {
+			return repos, nil
+		}
+
+		repos = append(repos, repoBuffer...)
+
+		page += 1
+	}
+}
+
+// matchesRepoFilter reports if a flag of the repository matches the configured filter,
+// unset filters match every repository
+func matchesRepoFilter(d *schema.ResourceData, key string, value bool) bool {
+	filter, ok := d.GetOkExists(key)
+	return !ok || filter.(bool) == value
+}
+
+func dataSourceGiteaReposRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get("owner").(string)
+	keyword := d.Get("keyword").(string)
+	topic := d.Get("topic").(string)
+
+	var repos []*gitea.Repository
+	var err error
+	if owner != "" && keyword == "" && topic == "" {
+		repos, err = getAllOwnerRepos(client, owner)
+	} else {
+		opt := gitea.SearchRepoOptions{
+			Keyword: keyword,
+		}
+		if topic != "" {
+			opt.Keyword = topic
+			opt.KeywordIsTopic = true
+		}
+		if owner != "" {
+			user, _, err := client.GetUserInfo(owner)
+			if err != nil {
+				return err
+			}
+			opt.OwnerID = user.ID
+		}
+		repos, err = searchAllRepos(client, opt)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The remaining filters are applied here, the SDK does not encode
+	// the private and archived flags correctly for the search API
+	var result []map[string]interface{}
+	for _, repo := range repos {
+		if !matchesRepoFilter(d, "private", repo.Private) ||
+			!matchesRepoFilter(d, "archived", repo.Archived) ||
+			!matchesRepoFilter(d, "mirror", repo.Mirror) ||
+			!matchesRepoFilter(d, "template", repo.Template) ||
+			!matchesRepoFilter(d, "fork", repo.Fork) {
+			continue
+		}
+
+		topics, err := getAllRepoTopics(client, repo.Owner.UserName, repo.Name)
+		if err != nil {
+			return err
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":               repo.ID,
+			"username":         repo.Owner.UserName,
+			"name":             repo.Name,
+			"full_name":        repo.FullName,
+			"description":      repo.Description,
+			"private":          repo.Private,
+			"fork":             repo.Fork,
+			"mirror":           repo.Mirror,
+			"size":             repo.Size,
+			"html_url":         repo.HTMLURL,
+			"ssh_url":          repo.SSHURL,
+			"clone_url":        repo.CloneURL,
+			"website":          repo.Website,
+			"stars":            repo.Stars,
+			"forks":            repo.Forks,
+			"watchers":         repo.Watchers,
+			"open_issue_count": repo.OpenIssues,
+			"default_branch":   repo.DefaultBranch,
+			"created":          repo.Created.String(),
+			"updated":          repo.Updated.String(),
+			"permission_admin": repo.Permissions != nil && repo.Permissions.Admin,
+			"permission_push":  repo.Permissions != nil && repo.Permissions.Push,
+			"permission_pull":  repo.Permissions != nil && repo.Permissions.Pull,
+			"topics":           schema.NewSet(schema.HashString, CollapseStringList(topics)),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, keyword, topic))
+	return d.Set("repos", result)
+}
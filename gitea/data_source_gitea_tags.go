@@ -0,0 +1,116 @@
+package gitea
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGiteaTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			"repo": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository",
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zipball_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tarball_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "All tags of the repository",
+			},
+		},
+		Description: "`gitea_tags` lists all tags of a repository",
+	}
+}
+
+func getAllRepoTags(c *gitea.Client, owner string, repo string) (tags []*gitea.Tag, err error) {
+	page := 1
+
+	for {
+		tagBuffer, _, err := c.ListRepoTags(owner, repo, gitea.ListRepoTagsOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(tagBuffer) == 0 {
+			return tags, nil
+		}
+
+		tags = append(tags, tagBuffer...)
+
+		page += 1
+	}
+}
+
+func dataSourceGiteaTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repo").(string)
+
+	tags, err := getAllRepoTags(client, owner, repo)
+	if err != nil {
+		return err
+	}
+
+	var result []map[string]interface{}
+	for _, tag := range tags {
+		var sha string
+		if tag.Commit != nil {
+			sha = tag.Commit.SHA
+		}
+		result = append(result, map[string]interface{}{
+			"name":        tag.Name,
+			"message":     tag.Message,
+			"commit_sha":  sha,
+			"tag_sha":     tag.ID,
+			"zipball_url": tag.ZipballURL,
+			"tarball_url": tag.TarballURL,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repo))
+	return d.Set("tags", result)
+}
@@ -1 +1,152 @@
 package gitea
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGiteaTeamAttributes are the attributes of a team shared by gitea_team and gitea_teams
+func dataSourceGiteaTeamAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"permission": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"can_create_repos": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"include_all_repositories": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"units": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"units_map": {
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+	}
+}
+
+func flattenTeam(team *gitea.Team) map[string]interface{} {
+	return map[string]interface{}{
+		"team_id":                  team.ID,
+		"name":                     team.Name,
+		"description":              team.Description,
+		"permission":               string(team.Permission),
+		"can_create_repos":         team.CanCreateOrgRepo,
+		"include_all_repositories": team.IncludesAllRepositories,
+		"units":                    flattenTeamUnits(team.Units),
+		"units_map":                team.UnitsMap,
+	}
+}
+
+func getAllOrgTeams(c *gitea.Client, org string) (teams []*gitea.Team, err error) {
+	page := 1
+
+	for {
+		teamBuffer, _, err := c.ListOrgTeams(org, gitea.ListTeamsOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(teamBuffer) == 0 {
+			return teams, nil
+		}
+
+		teams = append(teams, teamBuffer...)
+
+		page += 1
+	}
+}
+
+func dataSourceGiteaTeam() *schema.Resource {
+	attributes := dataSourceGiteaTeamAttributes()
+	attributes["team_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"team_id", "name"},
+		Description:  "The ID of the team",
+	}
+	attributes["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		RequiredWith: []string{"organisation"},
+		Description:  "The name of the team, requires `organisation`",
+	}
+	attributes["organisation"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The organisation the team is part of",
+	}
+
+	return &schema.Resource{
+		Read:        dataSourceGiteaTeamRead,
+		Schema:      attributes,
+		Description: "`gitea_team` looks up a team by its ID or by its organisation and name",
+	}
+}
+
+func dataSourceGiteaTeamRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitea.Client)
+
+	var team *gitea.Team
+
+	if id, ok := d.GetOk("team_id"); ok {
+		var err error
+		team, _, err = client.GetTeam(int64(id.(int)))
+		if err != nil {
+			return err
+		}
+	} else {
+		org := d.Get("organisation").(string)
+		name := d.Get("name").(string)
+
+		teams, err := getAllOrgTeams(client, org)
+		if err != nil {
+			return err
+		}
+		for _, t := range teams {
+			if t.Name == name {
+				team = t
+				break
+			}
+		}
+		if team == nil {
+			return fmt.Errorf("team %s could not be found in organisation %s", name, org)
+		}
+	}
+
+	for key, value := range flattenTeam(team) {
+		d.Set(key, value)
+	}
+	if team.Organization != nil {
+		d.Set("organisation", team.Organization.UserName)
+	}
+	d.SetId(fmt.Sprintf("%d", team.ID))
+
+	return nil
+}
@@ -1 +1,58 @@
 package gitea
+
+import (
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGiteaTeams() *schema.Resource {
+	attributes := dataSourceGiteaTeamAttributes()
+	attributes["team_id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	attributes["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Read: dataSourceGiteaTeamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organisation": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The organisation to list the teams of",
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: attributes,
+				},
+				Description: "All teams of the organisation",
+			},
+		},
+		Description: "`gitea_teams` lists all teams of an organisation with their permissions",
+	}
+}
+
+func dataSourceGiteaTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitea.Client)
+
+	org := d.Get("organisation").(string)
+
+	teams, err := getAllOrgTeams(client, org)
+	if err != nil {
+		return err
+	}
+
+	var result []map[string]interface{}
+	for _, team := range teams {
+		result = append(result, flattenTeam(team))
+	}
+
+	d.SetId(org)
+	return d.Set("teams", result)
+}
@@ -1 +1,45 @@
 package gitea
+
+import (
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGiteaTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGiteaTeamMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the team",
+			},
+			"members": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The usernames of all members of the team",
+			},
+		},
+		Description: "`gitea_team_members` lists the members of a team",
+	}
+}
+
+func dataSourceGiteaTeamMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitea.Client)
+
+	teamId := int64(d.Get("team_id").(int))
+
+	members, err := getAllTeamMembers(client, teamId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", teamId))
+	return d.Set("members", members)
+}
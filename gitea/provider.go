@@ -62,28 +62,45 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"gitea_user": dataSourceGiteaUser(),
-			"gitea_org":  dataSourceGiteaOrg(),
-			// "gitea_team":   dataSourceGiteaTeam(),
-			// "gitea_teams":  dataSourceGiteaTeams(),
-			// "gitea_team_members":  dataSourceGiteaTeamMembers(),
-			"gitea_repo": dataSourceGiteaRepo(),
-			// "gitea_repos":  dataSourceGiteaRepos(),
+			"gitea_user":         dataSourceGiteaUser(),
+			"gitea_org":          dataSourceGiteaOrg(),
+			"gitea_team":         dataSourceGiteaTeam(),
+			"gitea_teams":        dataSourceGiteaTeams(),
+			"gitea_team_members": dataSourceGiteaTeamMembers(),
+			"gitea_repo":         dataSourceGiteaRepo(),
+			"gitea_repos":        dataSourceGiteaRepos(),
+			"gitea_branches":     dataSourceGiteaBranches(),
+			"gitea_tags":         dataSourceGiteaTags(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"gitea_org": resourceGiteaOrg(),
 			// "gitea_team":       resourceGiteaTeam(),
 			// "gitea_repo":       resourceGiteaRepo(),
-			"gitea_user":           resourceGiteaUser(),
-			"gitea_oauth2_app":     resourceGiteaOauthApp(),
-			"gitea_repository":     resourceGiteaRepository(),
-			"gitea_fork":           resourceGiteaFork(),
-			"gitea_public_key":     resourceGiteaPublicKey(),
-			"gitea_team":           resourceGiteaTeam(),
-			"gitea_git_hook":       resourceGiteaGitHook(),
-			"gitea_token":          resourceGiteaToken(),
-			"gitea_repository_key": resourceGiteaRepositoryKey(),
+			"gitea_user":                    resourceGiteaUser(),
+			"gitea_oauth2_app":              resourceGiteaOauthApp(),
+			"gitea_repository":              resourceGiteaRepository(),
+			"gitea_fork":                    resourceGiteaFork(),
+			"gitea_public_key":              resourceGiteaPublicKey(),
+			"gitea_team":                    resourceGiteaTeam(),
+			"gitea_git_hook":                resourceGiteaGitHook(),
+			"gitea_token":                   resourceGiteaToken(),
+			"gitea_repository_key":          resourceGiteaRepositoryKey(),
+			"gitea_branch_protection":       resourceGiteaBranchProtection(),
+			"gitea_repository_webhook":      resourceGiteaRepositoryWebhook(),
+			"gitea_org_webhook":             resourceGiteaOrgWebhook(),
+			"gitea_repository_collaborator": resourceGiteaRepositoryCollaborator(),
+			"gitea_repository_team":         resourceGiteaRepositoryTeam(),
+			"gitea_branch":                  resourceGiteaBranch(),
+			"gitea_repository_file":         resourceGiteaRepositoryFile(),
+			"gitea_release":                 resourceGiteaRelease(),
+			"gitea_release_attachment":      resourceGiteaReleaseAttachment(),
+			"gitea_repository_label":        resourceGiteaRepositoryLabel(),
+			"gitea_repository_labels":       resourceGiteaRepositoryLabels(),
+			"gitea_repository_milestone":    resourceGiteaRepositoryMilestone(),
+			"gitea_tag":                     resourceGiteaTag(),
+			"gitea_repository_mirror_sync":  resourceGiteaRepositoryMirrorSync(),
+			"gitea_team_membership":         resourceGiteaTeamMembership(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -126,3 +143,16 @@ func validateAPIURLVersion(value interface{}, key string) (ws []string, es []err
 	}
 	return
 }
+
+func validateValueInList(valid []string) schema.SchemaValidateFunc {
+	return func(value interface{}, key string) (ws []string, es []error) {
+		v := value.(string)
+		for _, s := range valid {
+			if v == s {
+				return
+			}
+		}
+		es = append(es, fmt.Errorf("%s must be one of %s, got %q", key, strings.Join(valid, ", "), v))
+		return
+	}
+}
@@ -0,0 +1,156 @@
+package gitea
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	branchOwner        string = "owner"
+	branchRepo         string = "repo"
+	branchName         string = "name"
+	branchSourceBranch string = "source_branch"
+	branchCommitSha    string = "commit_sha"
+	branchProtected    string = "protected"
+)
+
+func resourceBranchIdParts(d *schema.ResourceData) (bool, string, string, string) {
+	// branch names may contain slashes, e.g. release/1.0
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return false, "", "", ""
+	}
+	return true, parts[0], parts[1], parts[2]
+}
+
+func resourceBranchRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, name := resourceBranchIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid branch id %q, expected owner/repo/branch", d.Id())
+	}
+
+	branch, resp, err := client.GetRepoBranch(owner, repo, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setBranchResourceData(owner, repo, branch, d)
+
+	return
+}
+
+func resourceBranchCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(branchOwner).(string)
+	repo := d.Get(branchRepo).(string)
+
+	branch, _, err := client.CreateBranch(owner, repo, gitea.CreateBranchOption{
+		BranchName:    d.Get(branchName).(string),
+		OldBranchName: d.Get(branchSourceBranch).(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setBranchResourceData(owner, repo, branch, d)
+
+	return
+}
+
+func resourceBranchDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, name := resourceBranchIdParts(d)
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	deleted, resp, err := client.DeleteRepoBranch(owner, repo, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("branch %s of %s/%s could not be deleted", name, owner, repo)
+	}
+
+	return
+}
+
+func setBranchResourceData(owner string, repo string, branch *gitea.Branch, d *schema.ResourceData) (err error) {
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, branch.Name))
+	d.Set(branchOwner, owner)
+	d.Set(branchRepo, repo)
+	d.Set(branchName, branch.Name)
+	d.Set(branchProtected, branch.Protected)
+	if branch.Commit != nil {
+		d.Set(branchCommitSha, branch.Commit.ID)
+	}
+	return
+}
+
+func resourceGiteaBranch() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceBranchRead,
+		Create: resourceBranchCreate,
+		Delete: resourceBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			branchOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			branchRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			branchName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the branch",
+			},
+			branchSourceBranch: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "The branch the new branch is created from. Defaults to the default branch of the repository",
+			},
+			branchCommitSha: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit the branch points to",
+			},
+			branchProtected: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag if the branch is protected",
+			},
+		},
+		Description: "`gitea_branch` manages a branch of a repository.\n\n" +
+			"The branch is only created from `source_branch`, changes to the branch content are not tracked. " +
+			"Creating a branch from a specific commit is not supported by the gitea SDK used by this provider.\n\n" +
+			"Import is supported using the id `owner/repo/branch`",
+	}
+}
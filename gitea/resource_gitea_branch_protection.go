@@ -0,0 +1,438 @@
+package gitea
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	branchProtectionOwner                         string = "owner"
+	branchProtectionRepo                          string = "repo"
+	branchProtectionBranch                        string = "branch"
+	branchProtectionRuleName                      string = "rule_name"
+	branchProtectionEnablePush                    string = "enable_push"
+	branchProtectionEnablePushWhitelist           string = "enable_push_whitelist"
+	branchProtectionPushWhitelistUsernames        string = "push_whitelist_usernames"
+	branchProtectionPushWhitelistTeams            string = "push_whitelist_teams"
+	branchProtectionPushWhitelistDeployKeys       string = "push_whitelist_deploy_keys"
+	branchProtectionEnableMergeWhitelist          string = "enable_merge_whitelist"
+	branchProtectionMergeWhitelistUsernames       string = "merge_whitelist_usernames"
+	branchProtectionMergeWhitelistTeams           string = "merge_whitelist_teams"
+	branchProtectionEnableStatusCheck             string = "enable_status_check"
+	branchProtectionStatusCheckContexts           string = "status_check_contexts"
+	branchProtectionRequiredApprovals             string = "required_approvals"
+	branchProtectionEnableApprovalsWhitelist      string = "enable_approvals_whitelist"
+	branchProtectionApprovalsWhitelistUsernames   string = "approvals_whitelist_usernames"
+	branchProtectionApprovalsWhitelistTeams       string = "approvals_whitelist_teams"
+	branchProtectionBlockOnRejectedReviews        string = "block_on_rejected_reviews"
+	branchProtectionBlockOnOfficialReviewRequests string = "block_on_official_review_requests"
+	branchProtectionBlockOnOutdatedBranch         string = "block_on_outdated_branch"
+	branchProtectionDismissStaleApprovals         string = "dismiss_stale_approvals"
+	branchProtectionRequireSignedCommits          string = "require_signed_commits"
+	branchProtectionProtectedFilePatterns         string = "protected_file_patterns"
+	branchProtectionUnprotectedFilePatterns       string = "unprotected_file_patterns"
+	branchProtectionCreated                       string = "created"
+	branchProtectionUpdated                       string = "updated"
+)
+
+func resourceBranchProtectionIdParts(d *schema.ResourceData) (bool, string, string, string) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return false, "", "", ""
+	}
+	return true, parts[0], parts[1], parts[2]
+}
+
+func expandBranchProtectionSet(d *schema.ResourceData, key string) []string {
+	return ExpandStringList(d.Get(key).(*schema.Set).List())
+}
+
+func resourceBranchProtectionRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, rule := resourceBranchProtectionIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid branch protection id %q, expected owner/repo/rule_name", d.Id())
+	}
+
+	bp, resp, err := client.GetBranchProtection(owner, repo, rule)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setBranchProtectionResourceData(owner, repo, bp, d)
+
+	return
+}
+
+func resourceBranchProtectionCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(branchProtectionOwner).(string)
+	repo := d.Get(branchProtectionRepo).(string)
+
+	opts := gitea.CreateBranchProtectionOption{
+		BranchName:                    d.Get(branchProtectionBranch).(string),
+		RuleName:                      d.Get(branchProtectionRuleName).(string),
+		EnablePush:                    d.Get(branchProtectionEnablePush).(bool),
+		EnablePushWhitelist:           d.Get(branchProtectionEnablePushWhitelist).(bool),
+		PushWhitelistUsernames:        expandBranchProtectionSet(d, branchProtectionPushWhitelistUsernames),
+		PushWhitelistTeams:            expandBranchProtectionSet(d, branchProtectionPushWhitelistTeams),
+		PushWhitelistDeployKeys:       d.Get(branchProtectionPushWhitelistDeployKeys).(bool),
+		EnableMergeWhitelist:          d.Get(branchProtectionEnableMergeWhitelist).(bool),
+		MergeWhitelistUsernames:       expandBranchProtectionSet(d, branchProtectionMergeWhitelistUsernames),
+		MergeWhitelistTeams:           expandBranchProtectionSet(d, branchProtectionMergeWhitelistTeams),
+		EnableStatusCheck:             d.Get(branchProtectionEnableStatusCheck).(bool),
+		StatusCheckContexts:           expandBranchProtectionSet(d, branchProtectionStatusCheckContexts),
+		RequiredApprovals:             int64(d.Get(branchProtectionRequiredApprovals).(int)),
+		EnableApprovalsWhitelist:      d.Get(branchProtectionEnableApprovalsWhitelist).(bool),
+		ApprovalsWhitelistUsernames:   expandBranchProtectionSet(d, branchProtectionApprovalsWhitelistUsernames),
+		ApprovalsWhitelistTeams:       expandBranchProtectionSet(d, branchProtectionApprovalsWhitelistTeams),
+		BlockOnRejectedReviews:        d.Get(branchProtectionBlockOnRejectedReviews).(bool),
+		BlockOnOfficialReviewRequests: d.Get(branchProtectionBlockOnOfficialReviewRequests).(bool),
+		BlockOnOutdatedBranch:         d.Get(branchProtectionBlockOnOutdatedBranch).(bool),
+		DismissStaleApprovals:         d.Get(branchProtectionDismissStaleApprovals).(bool),
+		RequireSignedCommits:          d.Get(branchProtectionRequireSignedCommits).(bool),
+		ProtectedFilePatterns:         d.Get(branchProtectionProtectedFilePatterns).(string),
+		UnprotectedFilePatterns:       d.Get(branchProtectionUnprotectedFilePatterns).(string),
+	}
+
+	bp, _, err := client.CreateBranchProtection(owner, repo, opts)
+	if err != nil {
+		return err
+	}
+
+	err = setBranchProtectionResourceData(owner, repo, bp, d)
+
+	return
+}
+
+func resourceBranchProtectionUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, rule := resourceBranchProtectionIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid branch protection id %q, expected owner/repo/rule_name", d.Id())
+	}
+
+	var enablePush bool = d.Get(branchProtectionEnablePush).(bool)
+	var enablePushWhitelist bool = d.Get(branchProtectionEnablePushWhitelist).(bool)
+	var pushWhitelistDeployKeys bool = d.Get(branchProtectionPushWhitelistDeployKeys).(bool)
+	var enableMergeWhitelist bool = d.Get(branchProtectionEnableMergeWhitelist).(bool)
+	var enableStatusCheck bool = d.Get(branchProtectionEnableStatusCheck).(bool)
+	var requiredApprovals int64 = int64(d.Get(branchProtectionRequiredApprovals).(int))
+	var enableApprovalsWhitelist bool = d.Get(branchProtectionEnableApprovalsWhitelist).(bool)
+	var blockOnRejectedReviews bool = d.Get(branchProtectionBlockOnRejectedReviews).(bool)
+	var blockOnOfficialReviewRequests bool = d.Get(branchProtectionBlockOnOfficialReviewRequests).(bool)
+	var blockOnOutdatedBranch bool = d.Get(branchProtectionBlockOnOutdatedBranch).(bool)
+	var dismissStaleApprovals bool = d.Get(branchProtectionDismissStaleApprovals).(bool)
+	var requireSignedCommits bool = d.Get(branchProtectionRequireSignedCommits).(bool)
+	var protectedFilePatterns string = d.Get(branchProtectionProtectedFilePatterns).(string)
+	var unprotectedFilePatterns string = d.Get(branchProtectionUnprotectedFilePatterns).(string)
+
+	opts := gitea.EditBranchProtectionOption{
+		EnablePush:                    &enablePush,
+		EnablePushWhitelist:           &enablePushWhitelist,
+		PushWhitelistUsernames:        expandBranchProtectionSet(d, branchProtectionPushWhitelistUsernames),
+		PushWhitelistTeams:            expandBranchProtectionSet(d, branchProtectionPushWhitelistTeams),
+		PushWhitelistDeployKeys:       &pushWhitelistDeployKeys,
+		EnableMergeWhitelist:          &enableMergeWhitelist,
+		MergeWhitelistUsernames:       expandBranchProtectionSet(d, branchProtectionMergeWhitelistUsernames),
+		MergeWhitelistTeams:           expandBranchProtectionSet(d, branchProtectionMergeWhitelistTeams),
+		EnableStatusCheck:             &enableStatusCheck,
+		StatusCheckContexts:           expandBranchProtectionSet(d, branchProtectionStatusCheckContexts),
+		RequiredApprovals:             &requiredApprovals,
+		EnableApprovalsWhitelist:      &enableApprovalsWhitelist,
+		ApprovalsWhitelistUsernames:   expandBranchProtectionSet(d, branchProtectionApprovalsWhitelistUsernames),
+		ApprovalsWhitelistTeams:       expandBranchProtectionSet(d, branchProtectionApprovalsWhitelistTeams),
+		BlockOnRejectedReviews:        &blockOnRejectedReviews,
+		BlockOnOfficialReviewRequests: &blockOnOfficialReviewRequests,
+		BlockOnOutdatedBranch:         &blockOnOutdatedBranch,
+		DismissStaleApprovals:         &dismissStaleApprovals,
+		RequireSignedCommits:          &requireSignedCommits,
+		ProtectedFilePatterns:         &protectedFilePatterns,
+		UnprotectedFilePatterns:       &unprotectedFilePatterns,
+	}
+
+	bp, _, err := client.EditBranchProtection(owner, repo, rule, opts)
+	if err != nil {
+		return err
+	}
+
+	err = setBranchProtectionResourceData(owner, repo, bp, d)
+
+	return
+}
+
+func resourceBranchProtectionDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, rule := resourceBranchProtectionIdParts(d)
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteBranchProtection(owner, repo, rule)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setBranchProtectionResourceData(owner string, repo string, bp *gitea.BranchProtection, d *schema.ResourceData) (err error) {
+	// Servers without named rules do not report a rule name
+	ruleName := bp.RuleName
+	if ruleName == "" {
+		ruleName = bp.BranchName
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, ruleName))
+
+	// Servers with named rules report the rule name as branch name,
+	// so the branch is only read for imports and rules named after it
+	branch := d.Get(branchProtectionBranch).(string)
+	if branch == "" || branch == ruleName || bp.RuleName == "" {
+		branch = bp.BranchName
+	}
+
+	for k, v := range map[string]interface{}{
+		branchProtectionOwner:                         owner,
+		branchProtectionRepo:                          repo,
+		branchProtectionBranch:                        branch,
+		branchProtectionRuleName:                      ruleName,
+		branchProtectionEnablePush:                    bp.EnablePush,
+		branchProtectionEnablePushWhitelist:           bp.EnablePushWhitelist,
+		branchProtectionPushWhitelistUsernames:        schema.NewSet(schema.HashString, CollapseStringList(bp.PushWhitelistUsernames)),
+		branchProtectionPushWhitelistTeams:            schema.NewSet(schema.HashString, CollapseStringList(bp.PushWhitelistTeams)),
+		branchProtectionPushWhitelistDeployKeys:       bp.PushWhitelistDeployKeys,
+		branchProtectionEnableMergeWhitelist:          bp.EnableMergeWhitelist,
+		branchProtectionMergeWhitelistUsernames:       schema.NewSet(schema.HashString, CollapseStringList(bp.MergeWhitelistUsernames)),
+		branchProtectionMergeWhitelistTeams:           schema.NewSet(schema.HashString, CollapseStringList(bp.MergeWhitelistTeams)),
+		branchProtectionEnableStatusCheck:             bp.EnableStatusCheck,
+		branchProtectionStatusCheckContexts:           schema.NewSet(schema.HashString, CollapseStringList(bp.StatusCheckContexts)),
+		branchProtectionRequiredApprovals:             int(bp.RequiredApprovals),
+		branchProtectionEnableApprovalsWhitelist:      bp.EnableApprovalsWhitelist,
+		branchProtectionApprovalsWhitelistUsernames:   schema.NewSet(schema.HashString, CollapseStringList(bp.ApprovalsWhitelistUsernames)),
+		branchProtectionApprovalsWhitelistTeams:       schema.NewSet(schema.HashString, CollapseStringList(bp.ApprovalsWhitelistTeams)),
+		branchProtectionBlockOnRejectedReviews:        bp.BlockOnRejectedReviews,
+		branchProtectionBlockOnOfficialReviewRequests: bp.BlockOnOfficialReviewRequests,
+		branchProtectionBlockOnOutdatedBranch:         bp.BlockOnOutdatedBranch,
+		branchProtectionDismissStaleApprovals:         bp.DismissStaleApprovals,
+		branchProtectionRequireSignedCommits:          bp.RequireSignedCommits,
+		branchProtectionProtectedFilePatterns:         bp.ProtectedFilePatterns,
+		branchProtectionUnprotectedFilePatterns:       bp.UnprotectedFilePatterns,
+		branchProtectionCreated:                       bp.Created.String(),
+		branchProtectionUpdated:                       bp.Updated.String(),
+	} {
+		err = d.Set(k, v)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func resourceGiteaBranchProtection() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceBranchProtectionRead,
+		Create: resourceBranchProtectionCreate,
+		Update: resourceBranchProtectionUpdate,
+		Delete: resourceBranchProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			branchProtectionOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			branchProtectionRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			branchProtectionBranch: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the branch that should be protected",
+			},
+			branchProtectionRuleName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Description: "The name of the protection rule, which can be a glob pattern protecting all matching branches. " +
+					"Defaults to `branch`",
+			},
+			branchProtectionEnablePush: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if pushing to the branch should be allowed at all",
+			},
+			branchProtectionEnablePushWhitelist: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if pushing should be restricted to the whitelisted users and teams",
+			},
+			branchProtectionPushWhitelistUsernames: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Users that are allowed to push to the branch",
+			},
+			branchProtectionPushWhitelistTeams: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Teams that are allowed to push to the branch",
+			},
+			branchProtectionPushWhitelistDeployKeys: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if deploy keys with write access are allowed to push to the branch",
+			},
+			branchProtectionEnableMergeWhitelist: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if merging pull requests should be restricted to the whitelisted users and teams",
+			},
+			branchProtectionMergeWhitelistUsernames: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Users that are allowed to merge pull requests into the branch",
+			},
+			branchProtectionMergeWhitelistTeams: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Teams that are allowed to merge pull requests into the branch",
+			},
+			branchProtectionEnableStatusCheck: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if status checks must pass before a pull request can be merged",
+			},
+			branchProtectionStatusCheckContexts: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Status check contexts that must pass before a pull request can be merged",
+			},
+			branchProtectionRequiredApprovals: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Number of approvals a pull request needs before it can be merged",
+			},
+			branchProtectionEnableApprovalsWhitelist: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if only approvals of the whitelisted users and teams should be counted",
+			},
+			branchProtectionApprovalsWhitelistUsernames: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Users whose approvals are counted",
+			},
+			branchProtectionApprovalsWhitelistTeams: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Teams whose approvals are counted",
+			},
+			branchProtectionBlockOnRejectedReviews: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if merging should be blocked while a review requests changes",
+			},
+			branchProtectionBlockOnOfficialReviewRequests: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if merging should be blocked while official review requests are pending",
+			},
+			branchProtectionBlockOnOutdatedBranch: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if merging should be blocked while the head branch is behind the base branch",
+			},
+			branchProtectionDismissStaleApprovals: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if approvals should be dismissed when new commits are pushed",
+			},
+			branchProtectionRequireSignedCommits: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if all commits pushed to the branch must be signed",
+			},
+			branchProtectionProtectedFilePatterns: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: "Semicolon separated list of glob patterns of files that must not be changed, " +
+					"even by users that are allowed to push",
+			},
+			branchProtectionUnprotectedFilePatterns: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: "Semicolon separated list of glob patterns of files that users with write access can push directly, " +
+					"bypassing the push restrictions of the branch",
+			},
+			branchProtectionCreated: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			branchProtectionUpdated: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Description: "`gitea_branch_protection` manages a branch protection rule of a repository.\n\n" +
+			"Import is supported using the id `owner/repo/rule_name`, for rules named after a branch the rule name is the branch name",
+	}
+}
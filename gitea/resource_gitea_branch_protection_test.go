@@ -0,0 +1,69 @@
+package gitea
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testBranchProtectionResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"branch_name":               "release/*",
+		"rule_name":                 "release/*",
+		"enable_push":               true,
+		"protected_file_patterns":   ".gitea/*",
+		"unprotected_file_patterns": "CHANGELOG.md",
+	}
+}
+
+func TestResourceBranchProtectionRead_readsRuleName(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test/branch_protections/release%2F%2A": testBranchProtectionResponse(),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaBranchProtection().Schema, map[string]interface{}{
+		"owner":     "lerentis",
+		"repo":      "test",
+		"branch":    "release/1.0",
+		"rule_name": "release/*",
+	})
+	d.SetId("lerentis/test/release/*")
+
+	if err := resourceBranchProtectionRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for attribute, value := range map[string]string{
+		"branch":                    "release/1.0",
+		"rule_name":                 "release/*",
+		"protected_file_patterns":   ".gitea/*",
+		"unprotected_file_patterns": "CHANGELOG.md",
+	} {
+		if v := d.Get(attribute).(string); v != value {
+			t.Errorf("Expected `%s` to be %q, but got %q", attribute, value, v)
+		}
+	}
+	if d.Id() != "lerentis/test/release/*" {
+		t.Errorf("Expected the id to be lerentis/test/release/*, but got %q", d.Id())
+	}
+}
+
+func TestResourceBranchProtectionRead_importsRuleName(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test/branch_protections/release%2F%2A": testBranchProtectionResponse(),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaBranchProtection().Schema, map[string]interface{}{})
+	d.SetId("lerentis/test/release/*")
+
+	if err := resourceBranchProtectionRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v := d.Get("branch").(string); v != "release/*" {
+		t.Errorf("Expected `branch` to be read from the server, but got %q", v)
+	}
+	if v := d.Get("rule_name").(string); v != "release/*" {
+		t.Errorf("Expected `rule_name` to be release/*, but got %q", v)
+	}
+}
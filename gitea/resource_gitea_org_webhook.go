@@ -0,0 +1,133 @@
+package gitea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOrgWebhookIdParts(d *schema.ResourceData) (bool, string, int64, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return false, "", 0, nil
+	}
+
+	hookId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return false, "", 0, err
+	}
+	return true, parts[0], hookId, nil
+}
+
+func resourceOrgWebhookRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, org, hookId, err := resourceOrgWebhookIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid webhook id %q, expected org/id", d.Id())
+	}
+
+	hook, resp, err := client.GetOrgHook(org, hookId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	d.Set(webhookOrg, org)
+	err = setWebhookResourceData(hook, d)
+
+	return
+}
+
+func resourceOrgWebhookCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	org := d.Get(webhookOrg).(string)
+
+	hook, _, err := client.CreateOrgHook(org, expandCreateHookOption(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", org, hook.ID))
+	err = setWebhookResourceData(hook, d)
+
+	return
+}
+
+func resourceOrgWebhookUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, org, hookId, err := resourceOrgWebhookIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid webhook id %q, expected org/id", d.Id())
+	}
+
+	_, err = client.EditOrgHook(org, hookId, expandEditHookOption(d))
+	if err != nil {
+		return err
+	}
+
+	// EditOrgHook does not return the hook
+	return resourceOrgWebhookRead(d, meta)
+}
+
+func resourceOrgWebhookDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, org, hookId, err := resourceOrgWebhookIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteOrgHook(org, hookId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func resourceGiteaOrgWebhook() *schema.Resource {
+	s := webhookSchema()
+	s[webhookOrg] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The organisation the webhook belongs to",
+	}
+
+	return &schema.Resource{
+		Read:   resourceOrgWebhookRead,
+		Create: resourceOrgWebhookCreate,
+		Update: resourceOrgWebhookUpdate,
+		Delete: resourceOrgWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: s,
+		Description: "`gitea_org_webhook` manages a webhook of an organisation. " +
+			"The webhook is triggered by events of all repositories of the organisation.\n\n" +
+			"Import is supported using the id `org/id`",
+	}
+}
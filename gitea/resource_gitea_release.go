@@ -0,0 +1,250 @@
+package gitea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	releaseOwner      string = "owner"
+	releaseRepo       string = "repo"
+	releaseTagName    string = "tag_name"
+	releaseTarget     string = "target"
+	releaseTitle      string = "title"
+	releaseBody       string = "body"
+	releaseDraft      string = "draft"
+	releasePrerelease string = "prerelease"
+	releaseReleaseId  string = "release_id"
+	releaseHTMLURL    string = "html_url"
+	releaseTarURL     string = "tarball_url"
+	releaseZipURL     string = "zipball_url"
+	releaseCreated    string = "created"
+	releasePublished  string = "published"
+)
+
+func resourceReleaseIdParts(d *schema.ResourceData) (bool, string, string, int64, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return false, "", "", 0, nil
+	}
+
+	releaseId, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return false, "", "", 0, err
+	}
+	return true, parts[0], parts[1], releaseId, nil
+}
+
+func resourceReleaseRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, releaseId, err := resourceReleaseIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid release id %q, expected owner/repo/id", d.Id())
+	}
+
+	release, resp, err := client.GetRelease(owner, repo, releaseId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setReleaseResourceData(owner, repo, release, d)
+
+	return
+}
+
+func resourceReleaseCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(releaseOwner).(string)
+	repo := d.Get(releaseRepo).(string)
+
+	release, _, err := client.CreateRelease(owner, repo, gitea.CreateReleaseOption{
+		TagName:      d.Get(releaseTagName).(string),
+		Target:       d.Get(releaseTarget).(string),
+		Title:        d.Get(releaseTitle).(string),
+		Note:         d.Get(releaseBody).(string),
+		IsDraft:      d.Get(releaseDraft).(bool),
+		IsPrerelease: d.Get(releasePrerelease).(bool),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setReleaseResourceData(owner, repo, release, d)
+
+	return
+}
+
+func resourceReleaseUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, releaseId, err := resourceReleaseIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid release id %q, expected owner/repo/id", d.Id())
+	}
+
+	var draft bool = d.Get(releaseDraft).(bool)
+	var prerelease bool = d.Get(releasePrerelease).(bool)
+
+	release, _, err := client.EditRelease(owner, repo, releaseId, gitea.EditReleaseOption{
+		TagName:      d.Get(releaseTagName).(string),
+		Target:       d.Get(releaseTarget).(string),
+		Title:        d.Get(releaseTitle).(string),
+		Note:         d.Get(releaseBody).(string),
+		IsDraft:      &draft,
+		IsPrerelease: &prerelease,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setReleaseResourceData(owner, repo, release, d)
+
+	return
+}
+
+func resourceReleaseDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, releaseId, err := resourceReleaseIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteRelease(owner, repo, releaseId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setReleaseResourceData(owner string, repo string, release *gitea.Release, d *schema.ResourceData) (err error) {
+	d.SetId(fmt.Sprintf("%s/%s/%d", owner, repo, release.ID))
+	d.Set(releaseOwner, owner)
+	d.Set(releaseRepo, repo)
+	d.Set(releaseTagName, release.TagName)
+	d.Set(releaseTarget, release.Target)
+	d.Set(releaseTitle, release.Title)
+	d.Set(releaseBody, release.Note)
+	d.Set(releaseDraft, release.IsDraft)
+	d.Set(releasePrerelease, release.IsPrerelease)
+	d.Set(releaseReleaseId, release.ID)
+	d.Set(releaseHTMLURL, release.HTMLURL)
+	d.Set(releaseTarURL, release.TarURL)
+	d.Set(releaseZipURL, release.ZipURL)
+	d.Set(releaseCreated, release.CreatedAt.String())
+	d.Set(releasePublished, release.PublishedAt.String())
+	return
+}
+
+func resourceGiteaRelease() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceReleaseRead,
+		Create: resourceReleaseCreate,
+		Update: resourceReleaseUpdate,
+		Delete: resourceReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			releaseOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			releaseRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			releaseTagName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The tag of the release. The tag is created from `target` if it does not exist yet",
+			},
+			releaseTarget: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The branch or commit the tag is created from. Defaults to the default branch of the repository",
+			},
+			releaseTitle: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the release",
+			},
+			releaseBody: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The release notes",
+			},
+			releaseDraft: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if the release is a draft",
+			},
+			releasePrerelease: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if the release is a prerelease",
+			},
+			releaseReleaseId: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The numeric ID of the release",
+			},
+			releaseHTMLURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			releaseTarURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			releaseZipURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			releaseCreated: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			releasePublished: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Description: "`gitea_release` manages a release of a repository.\n\n" +
+			"Deleting the release keeps its tag.\n\n" +
+			"Import is supported using the id `owner/repo/id`",
+	}
+}
@@ -0,0 +1,276 @@
+package gitea
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	releaseAttachmentOwner         string = "owner"
+	releaseAttachmentRepo          string = "repo"
+	releaseAttachmentReleaseId     string = "release_id"
+	releaseAttachmentSource        string = "source"
+	releaseAttachmentSourceHash    string = "source_sha256"
+	releaseAttachmentName          string = "name"
+	releaseAttachmentSize          string = "size"
+	releaseAttachmentUUID          string = "uuid"
+	releaseAttachmentDownloadURL   string = "download_url"
+	releaseAttachmentDownloadCount string = "download_count"
+	releaseAttachmentCreated       string = "created"
+)
+
+func resourceReleaseAttachmentIdParts(d *schema.ResourceData) (bool, string, string, int64, int64, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 {
+		return false, "", "", 0, 0, nil
+	}
+
+	releaseId, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return false, "", "", 0, 0, err
+	}
+	attachmentId, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return false, "", "", 0, 0, err
+	}
+	return true, parts[0], parts[1], releaseId, attachmentId, nil
+}
+
+func fileSha256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// replace the attachment whenever the content of the local file changes
+func resourceReleaseAttachmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
+	if !d.NewValueKnown(releaseAttachmentSource) {
+		return nil
+	}
+
+	hash, err := fileSha256(d.Get(releaseAttachmentSource).(string))
+	if err != nil {
+		return err
+	}
+
+	if d.Get(releaseAttachmentSourceHash).(string) != hash {
+		err = d.SetNew(releaseAttachmentSourceHash, hash)
+		if err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew(releaseAttachmentSourceHash)
+		}
+	}
+
+	return nil
+}
+
+func resourceReleaseAttachmentRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, releaseId, attachmentId, err := resourceReleaseAttachmentIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid release attachment id %q, expected owner/repo/release_id/id", d.Id())
+	}
+
+	attachment, resp, err := client.GetReleaseAttachment(owner, repo, releaseId, attachmentId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setReleaseAttachmentResourceData(owner, repo, releaseId, attachment, d)
+
+	return
+}
+
+func resourceReleaseAttachmentCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(releaseAttachmentOwner).(string)
+	repo := d.Get(releaseAttachmentRepo).(string)
+	releaseId := int64(d.Get(releaseAttachmentReleaseId).(int))
+	source := d.Get(releaseAttachmentSource).(string)
+
+	name := d.Get(releaseAttachmentName).(string)
+	if name == "" {
+		name = filepath.Base(source)
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	attachment, _, err := client.CreateReleaseAttachment(owner, repo, releaseId, file, name)
+	if err != nil {
+		return err
+	}
+
+	hash, err := fileSha256(source)
+	if err != nil {
+		return err
+	}
+	d.Set(releaseAttachmentSourceHash, hash)
+
+	err = setReleaseAttachmentResourceData(owner, repo, releaseId, attachment, d)
+
+	return
+}
+
+func resourceReleaseAttachmentUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, releaseId, attachmentId, err := resourceReleaseAttachmentIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid release attachment id %q, expected owner/repo/release_id/id", d.Id())
+	}
+
+	attachment, _, err := client.EditReleaseAttachment(owner, repo, releaseId, attachmentId, gitea.EditAttachmentOptions{
+		Name: d.Get(releaseAttachmentName).(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setReleaseAttachmentResourceData(owner, repo, releaseId, attachment, d)
+
+	return
+}
+
+func resourceReleaseAttachmentDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, releaseId, attachmentId, err := resourceReleaseAttachmentIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteReleaseAttachment(owner, repo, releaseId, attachmentId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setReleaseAttachmentResourceData(owner string, repo string, releaseId int64, attachment *gitea.Attachment, d *schema.ResourceData) (err error) {
+	d.SetId(fmt.Sprintf("%s/%s/%d/%d", owner, repo, releaseId, attachment.ID))
+	d.Set(releaseAttachmentOwner, owner)
+	d.Set(releaseAttachmentRepo, repo)
+	d.Set(releaseAttachmentReleaseId, releaseId)
+	d.Set(releaseAttachmentName, attachment.Name)
+	d.Set(releaseAttachmentSize, attachment.Size)
+	d.Set(releaseAttachmentUUID, attachment.UUID)
+	d.Set(releaseAttachmentDownloadURL, attachment.DownloadURL)
+	d.Set(releaseAttachmentDownloadCount, attachment.DownloadCount)
+	d.Set(releaseAttachmentCreated, attachment.Created.String())
+	return
+}
+
+func resourceGiteaReleaseAttachment() *schema.Resource {
+	return &schema.Resource{
+		Read:          resourceReleaseAttachmentRead,
+		Create:        resourceReleaseAttachmentCreate,
+		Update:        resourceReleaseAttachmentUpdate,
+		Delete:        resourceReleaseAttachmentDelete,
+		CustomizeDiff: resourceReleaseAttachmentCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			releaseAttachmentOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			releaseAttachmentRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			releaseAttachmentReleaseId: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The numeric ID of the release, e.g. `gitea_release.example.release_id`",
+			},
+			releaseAttachmentSource: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path of the local file that should be uploaded",
+			},
+			releaseAttachmentName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the attachment. Defaults to the file name of `source`",
+			},
+			releaseAttachmentSourceHash: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 of the uploaded file. The attachment is replaced whenever the local file changes",
+			},
+			releaseAttachmentSize: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			releaseAttachmentUUID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			releaseAttachmentDownloadURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			releaseAttachmentDownloadCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			releaseAttachmentCreated: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Description: "`gitea_release_attachment` uploads a local file as attachment of a release.\n\n" +
+			"The attachment is replaced whenever the content of the local file changes.\n" +
+			"import is currently not supported",
+	}
+}
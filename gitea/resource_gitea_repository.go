@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -50,8 +53,233 @@ const (
 	migrationMirrorInterval      string = "migration_mirror_interval"
 	migrationLFS                 string = "migration_lfs"
 	migrationLFSEndpoint         string = "migration_lfs_endpoint"
+	templateOwner                string = "template_owner"
+	templateRepo                 string = "template_repo"
+	templateGitContent           string = "template_git_content"
+	templateTopics               string = "template_topics"
+	templateGitHooks             string = "template_git_hooks"
+	templateWebhooks             string = "template_webhooks"
+	templateAvatar               string = "template_avatar"
+	templateLabels               string = "template_labels"
+	repoTopics                   string = "topics"
+	repoTransferTeamIds          string = "transfer_team_ids"
+	repoInternalTracker          string = "internal_tracker"
+	repoExternalTracker          string = "external_tracker"
+	repoExternalWiki             string = "external_wiki"
+	repoDefaultMergeStyle        string = "default_merge_style"
+	repoDestroyBehavior          string = "destroy_behavior"
+	repoImported                 string = "imported"
 )
 
+// same rules as enforced by gitea itself
+var validRepoTopic = regexp.MustCompile(`^[a-z0-9][-.a-z0-9]*$`)
+
+func validateMirrorInterval(value interface{}, key string) (ws []string, es []error) {
+	if _, err := time.ParseDuration(value.(string)); err != nil {
+		es = append(es, fmt.Errorf("%s must be a duration like `8h0m0s` or `0` to disable automatic sync, got %q", key, value.(string)))
+	}
+	return
+}
+
+// Settings only used on creation are unknown for imported repositories,
+// configuring them afterwards must not replace the repository
+func suppressImportedCreateOnlyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get(repoImported).(bool)
+}
+
+// The credentials of a migration are only used while the repository is
+// migrated, changing them afterwards has no effect
+func suppressMigrationCredentialsDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func validateRepoTopic(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if len(v) > 35 || !validRepoTopic.MatchString(v) {
+		es = append(es, fmt.Errorf("%s: topic %q is invalid; topics must start with a lowercase letter or number, "+
+			"can only contain lowercase letters, numbers, dashes and dots and can be up to 35 characters long", key, v))
+	}
+	return
+}
+
+func getAllRepoTopics(c *gitea.Client, owner string, name string) (topics []string, err error) {
+	page := 1
+
+	for {
+		topicBuffer, _, err := c.ListRepoTopics(owner, name, gitea.ListRepoTopicsOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(topicBuffer) == 0 {
+			return topics, nil
+		}
+
+		topics = append(topics, topicBuffer...)
+
+		page += 1
+	}
+}
+
+func setRepoTopicsResourceData(c *gitea.Client, repo *gitea.Repository, d *schema.ResourceData) (err error) {
+	topics, err := getAllRepoTopics(c, repo.Owner.UserName, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	return d.Set(repoTopics, schema.NewSet(schema.HashString, CollapseStringList(topics)))
+}
+
+func expandRepoInternalTracker(d *schema.ResourceData) *gitea.InternalTracker {
+	trackers := d.Get(repoInternalTracker).([]interface{})
+	if len(trackers) == 0 || trackers[0] == nil {
+		return nil
+	}
+
+	tracker := trackers[0].(map[string]interface{})
+	return &gitea.InternalTracker{
+		EnableTimeTracker:                tracker["enable_time_tracker"].(bool),
+		AllowOnlyContributorsToTrackTime: tracker["allow_only_contributors_to_track_time"].(bool),
+		EnableIssueDependencies:          tracker["enable_issue_dependencies"].(bool),
+	}
+}
+
+func expandRepoExternalTracker(d *schema.ResourceData) *gitea.ExternalTracker {
+	trackers := d.Get(repoExternalTracker).([]interface{})
+	if len(trackers) == 0 || trackers[0] == nil {
+		return nil
+	}
+
+	tracker := trackers[0].(map[string]interface{})
+	return &gitea.ExternalTracker{
+		ExternalTrackerURL:    tracker["url"].(string),
+		ExternalTrackerFormat: tracker["format"].(string),
+		ExternalTrackerStyle:  tracker["style"].(string),
+	}
+}
+
+func expandRepoExternalWiki(d *schema.ResourceData) *gitea.ExternalWiki {
+	wikis := d.Get(repoExternalWiki).([]interface{})
+	if len(wikis) == 0 || wikis[0] == nil {
+		return nil
+	}
+
+	wiki := wikis[0].(map[string]interface{})
+	return &gitea.ExternalWiki{
+		ExternalWikiURL: wiki["url"].(string),
+	}
+}
+
+func flattenRepoInternalTracker(tracker *gitea.InternalTracker) []interface{} {
+	if tracker == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enable_time_tracker":                   tracker.EnableTimeTracker,
+			"allow_only_contributors_to_track_time": tracker.AllowOnlyContributorsToTrackTime,
+			"enable_issue_dependencies":             tracker.EnableIssueDependencies,
+		},
+	}
+}
+
+func flattenRepoExternalTracker(tracker *gitea.ExternalTracker) []interface{} {
+	if tracker == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"url":    tracker.ExternalTrackerURL,
+			"format": tracker.ExternalTrackerFormat,
+			"style":  tracker.ExternalTrackerStyle,
+		},
+	}
+}
+
+func flattenRepoExternalWiki(wiki *gitea.ExternalWiki) []interface{} {
+	if wiki == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"url": wiki.ExternalWikiURL,
+		},
+	}
+}
+
+// rawConfigReader is implemented by schema.ResourceData and schema.ResourceDiff
+type rawConfigReader interface {
+	GetRawConfig() cty.Value
+}
+
+// isConfigured reports if an attribute or block is present in the configuration,
+// computed attributes carry the value read from the server otherwise
+func isConfigured(d rawConfigReader, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	value := config.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return false
+	}
+	return !value.CanIterateElements() || value.LengthInt() > 0
+}
+
+// every merge style can only be the default if it is allowed
+var repoMergeStyleFlags = map[string]string{
+	string(gitea.MergeStyleMerge):       repoAllowMerge,
+	string(gitea.MergeStyleRebase):      repoAllowRebase,
+	string(gitea.MergeStyleRebaseMerge): repoAllowRebaseMerge,
+	string(gitea.MergeStyleSquash):      repoAllowSquash,
+}
+
+func resourceRepoCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
+	hasIssues := d.Get(repoIssues).(bool)
+	hasWiki := d.Get(repoWiki).(bool)
+
+	if !hasIssues && isConfigured(d, repoInternalTracker) {
+		return fmt.Errorf("%s requires %s to be enabled", repoInternalTracker, repoIssues)
+	}
+	if !hasIssues && len(d.Get(repoExternalTracker).([]interface{})) > 0 {
+		return fmt.Errorf("%s requires %s to be enabled", repoExternalTracker, repoIssues)
+	}
+	if !hasWiki && len(d.Get(repoExternalWiki).([]interface{})) > 0 {
+		return fmt.Errorf("%s requires %s to be enabled", repoExternalWiki, repoWiki)
+	}
+
+	if isConfigured(d, repoDefaultMergeStyle) {
+		mergeStyle := d.Get(repoDefaultMergeStyle).(string)
+		flag := repoMergeStyleFlags[mergeStyle]
+		if d.NewValueKnown(flag) && !d.Get(flag).(bool) {
+			return fmt.Errorf("%s %q requires %s to be enabled", repoDefaultMergeStyle, mergeStyle, flag)
+		}
+	}
+
+	// The API offers no way to apply these changes to an existing repository
+	if d.Id() != "" {
+		imported := d.Get(repoImported).(bool)
+		if !imported && d.HasChanges(migrationServiceAuthName, migrationServiceAuthPassword, migrationServiceAuthToken) {
+			tflog.Warn(ctx, fmt.Sprintf("The migration credentials of %s/%s are only used while the repository is migrated, the change is ignored",
+				d.Get(repoOwner).(string), d.Get(repoName).(string)))
+		}
+		if !d.Get(repoMirror).(bool) && d.HasChange(migrationMirrorInterval) {
+			return fmt.Errorf("%s is only used by mirrors and can not be changed for other repositories", migrationMirrorInterval)
+		}
+	}
+
+	return nil
+}
+
 func searchUserByName(c *gitea.Client, name string) (res *gitea.User, err error) {
 	page := 1
 
@@ -80,6 +308,35 @@ func searchUserByName(c *gitea.Client, name string) (res *gitea.User, err error)
 	}
 }
 
+// repositories can be imported by their numeric id or by owner/name
+func resourceRepoImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*gitea.Client)
+
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err != nil {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid repository id %q, expected the numeric id or owner/name", d.Id())
+		}
+
+		repo, _, err := client.GetRepo(parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(fmt.Sprintf("%d", repo.ID))
+	}
+
+	// Settings only used on creation can not be read back,
+	// start with their defaults to avoid a diff right after the import
+	d.Set(repoImported, true)
+	for key, attribute := range resourceGiteaRepository().Schema {
+		if attribute.Default != nil {
+			d.Set(key, attribute.Default)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceRepoRead(d *schema.ResourceData, meta interface{}) (err error) {
 	client := meta.(*gitea.Client)
 
@@ -101,7 +358,12 @@ func resourceRepoRead(d *schema.ResourceData, meta interface{}) (err error) {
 		}
 	}
 
-	err = setRepoResourceData(repo, d)
+	err = setRepoResourceData(repo, d, meta)
+	if err != nil {
+		return err
+	}
+
+	err = setRepoTopicsResourceData(client, repo, d)
 
 	return
 }
@@ -171,6 +433,21 @@ func resourceRepoCreate(d *schema.ResourceData, meta interface{}) (err error) {
 
 		repo, _, err = client.MigrateRepo(opts)
 
+	} else if d.Get(templateRepo).(string) != "" {
+		opts := gitea.CreateRepoFromTemplateOption{
+			Owner:       d.Get(repoOwner).(string),
+			Name:        d.Get(repoName).(string),
+			Description: d.Get(repoDescription).(string),
+			Private:     d.Get(repoPrivateFlag).(bool),
+			GitContent:  d.Get(templateGitContent).(bool),
+			Topics:      d.Get(templateTopics).(bool),
+			GitHooks:    d.Get(templateGitHooks).(bool),
+			Webhooks:    d.Get(templateWebhooks).(bool),
+			Avatar:      d.Get(templateAvatar).(bool),
+			Labels:      d.Get(templateLabels).(bool),
+		}
+
+		repo, _, err = client.CreateRepoFromTemplate(d.Get(templateOwner).(string), d.Get(templateRepo).(string), opts)
 	} else {
 		opts := gitea.CreateRepoOption{
 			Name:          d.Get(repoName).(string),
@@ -201,9 +478,12 @@ func resourceRepoCreate(d *schema.ResourceData, meta interface{}) (err error) {
 		return err
 	}
 
-	err = setRepoResourceData(repo, d)
+	// Every way of creating a repository only takes a subset of the settings,
+	// everything else is applied like on a regular update.
+	// Only the id is stored, the remaining data would overwrite the configuration
+	d.SetId(fmt.Sprintf("%d", repo.ID))
 
-	return
+	return resourceRepoUpdate(d, meta)
 }
 
 func resourceRepoUpdate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -247,6 +527,14 @@ func resourceRepoUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 		AllowSquash:               &allowSquash,
 		AllowManualMerge:          &allowManualMerge,
 		AutodetectManualMerge:     &autodetectManualMerge,
+		InternalTracker:           expandRepoInternalTracker(d),
+		ExternalTracker:           expandRepoExternalTracker(d),
+		ExternalWiki:              expandRepoExternalWiki(d),
+	}
+
+	if mergeStyle, ok := d.GetOk(repoDefaultMergeStyle); ok {
+		var defaultMergeStyle gitea.MergeStyle = gitea.MergeStyle(mergeStyle.(string))
+		opts.DefaultMergeStyle = &defaultMergeStyle
 	}
 
 	if d.Get(repoMirror).(bool) {
@@ -257,26 +545,145 @@ func resourceRepoUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 		opts.Archived = &archived
 	}
 
-	repo, _, err = client.EditRepo(d.Get(repoOwner).(string), d.Get(repoName).(string), opts)
+	// On renames and transfers the repository has to be addressed
+	// by the owner and name it currently has
+	var owner string = d.Get(repoOwner).(string)
+	var currentName string = d.Get(repoName).(string)
+	// read before the response of the edit overwrites the owner
+	var newOwner string = d.Get(repoOwner).(string)
+	var transfer bool = !d.IsNewResource() && d.HasChange(repoOwner)
+	if !d.IsNewResource() {
+		oldOwner, _ := d.GetChange(repoOwner)
+		oldName, _ := d.GetChange(repoName)
+		owner = oldOwner.(string)
+		currentName = oldName.(string)
+	}
+
+	repo, _, err = client.EditRepo(owner, currentName, opts)
 
 	if err != nil {
 		return err
 	}
-	err = setRepoResourceData(repo, d)
+	err = setRepoResourceData(repo, d, meta)
+	if err != nil {
+		return err
+	}
+
+	if transfer {
+		transferOpts := gitea.TransferRepoOption{
+			NewOwner: newOwner,
+		}
+		if teamIds, ok := d.GetOk(repoTransferTeamIds); ok {
+			var ids []int64
+			for _, id := range teamIds.(*schema.Set).List() {
+				ids = append(ids, int64(id.(int)))
+			}
+			transferOpts.TeamIDs = &ids
+		}
+
+		repo, _, err = client.TransferRepo(repo.Owner.UserName, repo.Name, transferOpts)
+		if err != nil {
+			return err
+		}
+		err = setRepoResourceData(repo, d, meta)
+		if err != nil {
+			return err
+		}
+
+		// Transfers to other users have to be accepted by the new owner
+		if repo.Owner.UserName != newOwner {
+			return fmt.Errorf("transfer of repository %s to %s is pending and has to be accepted by the new owner", repo.FullName, newOwner)
+		}
+	}
+
+	if d.HasChange(repoTopics) {
+		_, err = client.SetRepoTopics(repo.Owner.UserName, repo.Name, ExpandStringList(d.Get(repoTopics).(*schema.Set).List()))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = setRepoTopicsResourceData(client, repo, d)
 
 	return
 
 }
 
+const (
+	destroyBehaviorDelete         string = "delete"
+	destroyBehaviorArchive        string = "archive"
+	destroyBehaviorFailIfNotEmpty string = "fail_if_not_empty"
+)
+
+func repoHasIssues(c *gitea.Client, owner string, name string) (bool, error) {
+	issues, _, err := c.ListRepoIssues(owner, name, gitea.ListIssueOption{
+		ListOptions: gitea.ListOptions{
+			Page:     1,
+			PageSize: 1,
+		},
+		State: gitea.StateAll,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(issues) > 0, nil
+}
+
 func respurceRepoDelete(d *schema.ResourceData, meta interface{}) (err error) {
 	client := meta.(*gitea.Client)
 
-	client.DeleteRepo(d.Get(repoOwner).(string), d.Get(repoName).(string))
+	owner := d.Get(repoOwner).(string)
+	name := d.Get(repoName).(string)
+
+	switch d.Get(repoDestroyBehavior).(string) {
+	case destroyBehaviorArchive:
+		var archived bool = true
+		_, resp, err := client.EditRepo(owner, name, gitea.EditRepoOption{
+			Archived: &archived,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				return nil
+			}
+			return err
+		}
+		tflog.Info(context.Background(), fmt.Sprintf("Repository %s/%s was archived instead of deleted", owner, name))
+		return nil
+	case destroyBehaviorFailIfNotEmpty:
+		repo, resp, err := client.GetRepo(owner, name)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				return nil
+			}
+			return err
+		}
+		if !repo.Empty {
+			return fmt.Errorf("repository %s/%s contains commits and is not deleted because %s is set to %s", owner, name, repoDestroyBehavior, destroyBehaviorFailIfNotEmpty)
+		}
+		hasIssues, err := repoHasIssues(client, owner, name)
+		if err != nil {
+			return err
+		}
+		if hasIssues {
+			return fmt.Errorf("repository %s/%s contains issues or pull requests and is not deleted because %s is set to %s", owner, name, repoDestroyBehavior, destroyBehaviorFailIfNotEmpty)
+		}
+	}
+
+	resp, err := client.DeleteRepo(owner, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
 
 	return
 }
 
-func setRepoResourceData(repo *gitea.Repository, d *schema.ResourceData) (err error) {
+func setRepoResourceData(repo *gitea.Repository, d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
 	d.SetId(fmt.Sprintf("%d", repo.ID))
 	d.Set("username", repo.Owner.UserName)
 	d.Set("name", repo.Name)
@@ -300,31 +707,67 @@ func setRepoResourceData(repo *gitea.Repository, d *schema.ResourceData) (err er
 	d.Set("permission_admin", repo.Permissions.Admin)
 	d.Set("permission_push", repo.Permissions.Push)
 	d.Set("permission_pull", repo.Permissions.Pull)
+	d.Set(repoIssues, repo.HasIssues)
+	d.Set(repoWiki, repo.HasWiki)
+	d.Set(repoPrs, repo.HasPullRequests)
+	d.Set(repoIgnoreWhitespace, repo.IgnoreWhitespaceConflicts)
+	d.Set(repoAllowMerge, repo.AllowMerge)
+	d.Set(repoAllowRebase, repo.AllowRebase)
+	d.Set(repoAllowRebaseMerge, repo.AllowRebaseMerge)
+	d.Set(repoAllowSquash, repo.AllowSquash)
+	d.Set(repoAchived, repo.Archived)
+	d.Set(repoTemplate, repo.Template)
+	d.Set(repoInternalTracker, flattenRepoInternalTracker(repo.InternalTracker))
+	d.Set(repoExternalTracker, flattenRepoExternalTracker(repo.ExternalTracker))
+	d.Set(repoExternalWiki, flattenRepoExternalWiki(repo.ExternalWiki))
+
+	// Older servers do not report the project flag, keep the configured value for them
+	if err := client.CheckServerVersionConstraint(">= 1.15.0"); err == nil {
+		d.Set(repoProjects, repo.HasProjects)
+	}
+	// Older servers do not report the default merge style
+	if repo.DefaultMergeStyle != "" {
+		d.Set(repoDefaultMergeStyle, string(repo.DefaultMergeStyle))
+	}
+	if repo.Mirror && repo.MirrorInterval != "" {
+		d.Set(migrationMirrorInterval, repo.MirrorInterval)
+	}
 
 	return
 }
 
 func resourceGiteaRepository() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceRepoRead,
-		Create: resourceRepoCreate,
-		Update: resourceRepoUpdate,
-		Delete: respurceRepoDelete,
+		Read:          resourceRepoRead,
+		Create:        resourceRepoCreate,
+		Update:        resourceRepoUpdate,
+		Delete:        respurceRepoDelete,
+		CustomizeDiff: resourceRepoCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRepoImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The Owner of the repository",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The Owner of the repository.\n" +
+					"Changing the owner transfers the repository to the new owner",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The Name of the repository",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The Name of the repository.\n" +
+					"Changing the name renames the repository",
+			},
+			"transfer_team_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Required: false,
+				Optional: true,
+				Description: "IDs of the teams that should get access to the repository " +
+					"when it is transferred to an organisation",
 			},
 			"auto_init": {
 				Type:        schema.TypeBool,
@@ -476,6 +919,20 @@ func resourceGiteaRepository() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"default_merge_style": {
+				Type:     schema.TypeString,
+				Required: false,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validateValueInList([]string{
+					string(gitea.MergeStyleMerge),
+					string(gitea.MergeStyleRebase),
+					string(gitea.MergeStyleRebaseMerge),
+					string(gitea.MergeStyleSquash),
+				}),
+				Description: "The merge style preselected for pull requests, one of `merge`, `rebase`, `rebase-merge` or `squash`.\n" +
+					"The corresponding `allow_*` flag has to be enabled",
+			},
 			"archived": {
 				Type:     schema.TypeBool,
 				Required: false,
@@ -501,44 +958,54 @@ func resourceGiteaRepository() *schema.Resource {
 				Default:  false,
 			},
 			"migration_clone_addresse": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "DEPRECATED in favor of `migration_clone_address`",
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Description:      "DEPRECATED in favor of `migration_clone_address`",
 			},
 			"migration_clone_address": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
 			},
 			"migration_service": {
-				Type:        schema.TypeString,
-				Required:    false,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "git/github/gitlab/gitea/gogs",
+				Type:             schema.TypeString,
+				Required:         false,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Optional:         true,
+				Description:      "git/github/gitlab/gitea/gogs",
 			},
 			"migration_service_auth_username": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: true,
-				Default:  "",
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				Default:          "",
+				DiffSuppressFunc: suppressMigrationCredentialsDiff,
+				Description: "Username used to authenticate against the migration source.\n" +
+					"Credentials are only used during the migration, changing them for an existing repository is ignored",
 			},
 			"migration_service_auth_password": {
-				Type:      schema.TypeString,
-				Required:  false,
-				Optional:  true,
-				Sensitive: true,
-				Default:   "",
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				Sensitive:        true,
+				Default:          "",
+				DiffSuppressFunc: suppressMigrationCredentialsDiff,
+				Description:      "Password of `migration_service_auth_username`",
 			},
 			"migration_service_auth_token": {
-				Type:      schema.TypeString,
-				Required:  false,
-				Optional:  true,
-				Sensitive: true,
-				Default:   "",
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				Sensitive:        true,
+				Default:          "",
+				DiffSuppressFunc: suppressMigrationCredentialsDiff,
+				Description:      "Token used to authenticate against the migration source, like the username changing it after the migration is ignored",
 			},
 			"migration_milestones": {
 				Type:     schema.TypeBool,
@@ -559,11 +1026,13 @@ func resourceGiteaRepository() *schema.Resource {
 				Default:  true,
 			},
 			"migration_mirror_interval": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				Default:     "8h0m0s",
-				Description: "valid time units are 'h', 'm', 's'. 0 to disable automatic sync",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				Default:      "8h0m0s",
+				ValidateFunc: validateMirrorInterval,
+				Description: "valid time units are 'h', 'm', 's'. 0 to disable automatic sync.\n" +
+					"Changes are applied in place for mirrors and rejected for other repositories",
 			},
 			"migration_lfs": {
 				Type:     schema.TypeBool,
@@ -576,6 +1045,194 @@ func resourceGiteaRepository() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
+			"template_owner": {
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				RequiredWith:     []string{"template_repo"},
+				ConflictsWith: []string{
+					"migration_clone_address",
+					"migration_clone_addresse",
+				},
+				Description: "The owner of the template repository the repository should be generated from",
+			},
+			"template_repo": {
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				RequiredWith:     []string{"template_owner"},
+				Description:      "The name of the template repository the repository should be generated from",
+			},
+			"template_git_content": {
+				Type:             schema.TypeBool,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Default:          true,
+				Description:      "Flag if the git content of the default branch of the template should be copied",
+			},
+			"template_topics": {
+				Type:             schema.TypeBool,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Default:          false,
+				Description:      "Flag if the topics of the template should be copied",
+			},
+			"template_git_hooks": {
+				Type:             schema.TypeBool,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Default:          false,
+				Description:      "Flag if the git hooks of the template should be copied",
+			},
+			"template_webhooks": {
+				Type:             schema.TypeBool,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Default:          false,
+				Description:      "Flag if the webhooks of the template should be copied",
+			},
+			"template_avatar": {
+				Type:             schema.TypeBool,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Default:          false,
+				Description:      "Flag if the avatar of the template should be copied",
+			},
+			"template_labels": {
+				Type:             schema.TypeBool,
+				Required:         false,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressImportedCreateOnlyDiff,
+				Default:          false,
+				Description:      "Flag if the issue labels of the template should be copied",
+			},
+			"topics": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRepoTopic,
+				},
+				Required: false,
+				Optional: true,
+				Computed: true,
+				MaxItems: 25,
+				Description: "Topics of the repository.\n" +
+					"Topics must start with a lowercase letter or number, can only contain lowercase letters, numbers, " +
+					"dashes and dots and can be up to 35 characters long",
+			},
+			"internal_tracker": {
+				Type:     schema.TypeList,
+				Required: false,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"external_tracker",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_time_tracker": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Flag if time tracking is enabled",
+						},
+						"allow_only_contributors_to_track_time": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Flag if only contributors can track time",
+						},
+						"enable_issue_dependencies": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Flag if issues and pull requests can depend on each other",
+						},
+					},
+				},
+				Description: "Settings of the built-in issue tracker. Requires `has_issues` to be enabled",
+			},
+			"external_tracker": {
+				Type:     schema.TypeList,
+				Required: false,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the external issue tracker",
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+							Description: "URL format of issues in the external tracker. " +
+								"The placeholders `{user}`, `{repo}` and `{index}` are replaced with the owner, the repository name and the issue index",
+						},
+						"style": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "numeric",
+							ValidateFunc: validateValueInList([]string{"numeric", "alphanumeric"}),
+							Description:  "Format of issue numbers in the external tracker, either `numeric` or `alphanumeric`",
+						},
+					},
+				},
+				Description: "Use an external issue tracker instead of the built-in one. Requires `has_issues` to be enabled",
+			},
+			"external_wiki": {
+				Type:     schema.TypeList,
+				Required: false,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the external wiki",
+						},
+					},
+				},
+				Description: "Use an external wiki instead of the built-in one. Requires `has_wiki` to be enabled",
+			},
+			"imported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag if the repository was imported. Changes to settings only used on creation, like the `template_` settings or the migration source and credentials, are ignored for imported repositories",
+			},
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Required: false,
+				Optional: true,
+				Default:  destroyBehaviorDelete,
+				ValidateFunc: validateValueInList([]string{
+					destroyBehaviorDelete,
+					destroyBehaviorArchive,
+					destroyBehaviorFailIfNotEmpty,
+				}),
+				Description: "What happens to the repository when the resource is destroyed.\n" +
+					"`delete` deletes the repository, `archive` archives it and leaves it in place, " +
+					"`fail_if_not_empty` only deletes it if it has neither commits nor issues or pull requests",
+			},
 			"clone_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -594,7 +1251,12 @@ func resourceGiteaRepository() *schema.Resource {
 			"If the `username` property is set to a organisation name, the provider will try to look if this organisation exists " +
 			"and create the repository under the organisation scope.\n\n" +
 			"Repository migrations have some properties that are not available to regular repositories. These are all prefixed with `migration_`.\n" +
+			"Repositories can be generated from a template repository by setting `template_owner` and `template_repo`. " +
+			"The properties controlling what is copied from the template are all prefixed with `template_`.\n" +
+			"A sync of a mirror can be forced with `gitea_repository_mirror_sync`.\n" +
 			"Codeberg.org does currently not allow mirrors to be created. See FAQ Section of CodeBerg for more information: " +
-			"https://docs.codeberg.org/getting-started/faq/#why-am-i-not-allowed-to-set-up-an-automatic-mirror",
+			"https://docs.codeberg.org/getting-started/faq/#why-am-i-not-allowed-to-set-up-an-automatic-mirror\n\n" +
+			"Import is supported using the numeric id or `owner/name`. " +
+			"The `migration_` and `template_` settings of imported repositories are only kept in the state, changing them never replaces the repository",
 	}
 }
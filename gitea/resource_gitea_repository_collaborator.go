@@ -0,0 +1,150 @@
+package gitea
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	collaboratorOwner      string = "owner"
+	collaboratorRepo       string = "repo"
+	collaboratorUsername   string = "username"
+	collaboratorPermission string = "permission"
+)
+
+func resourceRepoCollaboratorIdParts(d *schema.ResourceData) (bool, string, string, string) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return false, "", "", ""
+	}
+	return true, parts[0], parts[1], parts[2]
+}
+
+func resourceRepoCollaboratorRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, username := resourceRepoCollaboratorIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid collaborator id %q, expected owner/repo/username", d.Id())
+	}
+
+	isCollaborator, resp, err := client.IsCollaborator(owner, repo, username)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	if !isCollaborator {
+		d.SetId("")
+		return nil
+	}
+
+	permission, _, err := client.CollaboratorPermission(owner, repo, username)
+	if err != nil {
+		return err
+	}
+
+	d.Set(collaboratorOwner, owner)
+	d.Set(collaboratorRepo, repo)
+	d.Set(collaboratorUsername, username)
+	// Owners and site admins are reported with their effective access, which
+	// can not be granted to a collaborator, so the configured value is kept
+	if permission != nil {
+		switch permission.Permission {
+		case gitea.AccessModeRead, gitea.AccessModeWrite, gitea.AccessModeAdmin:
+			d.Set(collaboratorPermission, string(permission.Permission))
+		}
+	}
+
+	return
+}
+
+func resourceRepoCollaboratorUpcreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(collaboratorOwner).(string)
+	repo := d.Get(collaboratorRepo).(string)
+	username := d.Get(collaboratorUsername).(string)
+	permission := gitea.AccessMode(d.Get(collaboratorPermission).(string))
+
+	// Adding an existing collaborator updates its permission
+	_, err = client.AddCollaborator(owner, repo, username, gitea.AddCollaboratorOption{
+		Permission: &permission,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, username))
+
+	return resourceRepoCollaboratorRead(d, meta)
+}
+
+func resourceRepoCollaboratorDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, username := resourceRepoCollaboratorIdParts(d)
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteCollaborator(owner, repo, username)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func resourceGiteaRepositoryCollaborator() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceRepoCollaboratorRead,
+		Create: resourceRepoCollaboratorUpcreate,
+		Update: resourceRepoCollaboratorUpcreate,
+		Delete: resourceRepoCollaboratorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			collaboratorOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			collaboratorRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			collaboratorUsername: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The user that should be added as collaborator",
+			},
+			collaboratorPermission: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "write",
+				ValidateFunc: validateValueInList([]string{"read", "write", "admin"}),
+				Description:  "Permission of the collaborator. Can be `read`, `write` or `admin`",
+			},
+		},
+		Description: "`gitea_repository_collaborator` manages a single collaborator of a repository.\n\n" +
+			"Changes to the permission and removing the collaborator outside of terraform are detected.\n\n" +
+			"Import is supported using the id `owner/repo/username`",
+	}
+}
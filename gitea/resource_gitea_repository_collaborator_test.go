@@ -0,0 +1,72 @@
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testCollaboratorClient returns a client talking to a fake gitea server
+// on which octo is a collaborator of lerentis/test with the given permission
+func testCollaboratorClient(t *testing.T, permission string) *gitea.Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": "1.19.0"})
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/test/collaborators/octo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/test/collaborators/octo/permission", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"permission": permission})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return client
+}
+
+func TestResourceRepoCollaboratorRead_readsPermission(t *testing.T) {
+	client := testCollaboratorClient(t, "admin")
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryCollaborator().Schema, map[string]interface{}{})
+	d.SetId("lerentis/test/octo")
+
+	if err := resourceRepoCollaboratorRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if d.Id() == "" {
+		t.Fatalf("Expected the collaborator to be kept in state")
+	}
+	if v := d.Get("permission").(string); v != "admin" {
+		t.Errorf("Expected `permission` to be admin, but got %q", v)
+	}
+	if v := d.Get("username").(string); v != "octo" {
+		t.Errorf("Expected `username` to be octo, but got %q", v)
+	}
+}
+
+func TestResourceRepoCollaboratorRead_keepsPermissionOfOwners(t *testing.T) {
+	client := testCollaboratorClient(t, "owner")
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryCollaborator().Schema, map[string]interface{}{
+		"permission": "read",
+	})
+	d.SetId("lerentis/test/octo")
+
+	if err := resourceRepoCollaboratorRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v := d.Get("permission").(string); v != "read" {
+		t.Errorf("Expected `permission` to keep its configured value, but got %q", v)
+	}
+}
@@ -0,0 +1,257 @@
+package gitea
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	repoFileOwner             string = "owner"
+	repoFileRepo              string = "repo"
+	repoFilePath              string = "path"
+	repoFileBranch            string = "branch"
+	repoFileContent           string = "content"
+	repoFileCommitMessage     string = "commit_message"
+	repoFileAuthorName        string = "author_name"
+	repoFileAuthorEmail       string = "author_email"
+	repoFileCommitterName     string = "committer_name"
+	repoFileCommitterEmail    string = "committer_email"
+	repoFileOverwriteOnCreate string = "overwrite_on_create"
+	repoFileSha               string = "sha"
+	repoFileCommitSha         string = "commit_sha"
+)
+
+func expandRepoFileOptions(d *schema.ResourceData, defaultMessage string) gitea.FileOptions {
+	message := d.Get(repoFileCommitMessage).(string)
+	if message == "" {
+		message = defaultMessage
+	}
+
+	return gitea.FileOptions{
+		Message:    message,
+		BranchName: d.Get(repoFileBranch).(string),
+		Author: gitea.Identity{
+			Name:  d.Get(repoFileAuthorName).(string),
+			Email: d.Get(repoFileAuthorEmail).(string),
+		},
+		Committer: gitea.Identity{
+			Name:  d.Get(repoFileCommitterName).(string),
+			Email: d.Get(repoFileCommitterEmail).(string),
+		},
+	}
+}
+
+func resourceRepoFileRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(repoFileOwner).(string)
+	repo := d.Get(repoFileRepo).(string)
+	path := d.Get(repoFilePath).(string)
+
+	contents, resp, err := client.GetContents(owner, repo, d.Get(repoFileBranch).(string), path)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	if contents.Type != "file" {
+		return fmt.Errorf("%s in %s/%s is a %s and not a file", path, owner, repo, contents.Type)
+	}
+
+	if contents.Content != nil {
+		content, err := base64.StdEncoding.DecodeString(*contents.Content)
+		if err != nil {
+			return err
+		}
+		d.Set(repoFileContent, string(content))
+	}
+	d.Set(repoFileSha, contents.SHA)
+
+	return
+}
+
+func resourceRepoFileCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(repoFileOwner).(string)
+	repo := d.Get(repoFileRepo).(string)
+	path := d.Get(repoFilePath).(string)
+	content := base64.StdEncoding.EncodeToString([]byte(d.Get(repoFileContent).(string)))
+
+	existing, resp, err := client.GetContents(owner, repo, d.Get(repoFileBranch).(string), path)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return err
+	}
+
+	var file *gitea.FileResponse
+	if err == nil {
+		if !d.Get(repoFileOverwriteOnCreate).(bool) {
+			return fmt.Errorf("%s already exists in %s/%s, set %s to manage it anyway", path, owner, repo, repoFileOverwriteOnCreate)
+		}
+
+		file, _, err = client.UpdateFile(owner, repo, path, gitea.UpdateFileOptions{
+			FileOptions: expandRepoFileOptions(d, fmt.Sprintf("Update %s", path)),
+			SHA:         existing.SHA,
+			Content:     content,
+		})
+	} else {
+		file, _, err = client.CreateFile(owner, repo, path, gitea.CreateFileOptions{
+			FileOptions: expandRepoFileOptions(d, fmt.Sprintf("Add %s", path)),
+			Content:     content,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, path))
+	err = setRepoFileResourceData(file, d)
+
+	return
+}
+
+func resourceRepoFileUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	path := d.Get(repoFilePath).(string)
+
+	file, _, err := client.UpdateFile(d.Get(repoFileOwner).(string), d.Get(repoFileRepo).(string), path, gitea.UpdateFileOptions{
+		FileOptions: expandRepoFileOptions(d, fmt.Sprintf("Update %s", path)),
+		SHA:         d.Get(repoFileSha).(string),
+		Content:     base64.StdEncoding.EncodeToString([]byte(d.Get(repoFileContent).(string))),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setRepoFileResourceData(file, d)
+
+	return
+}
+
+func resourceRepoFileDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	path := d.Get(repoFilePath).(string)
+
+	resp, err := client.DeleteFile(d.Get(repoFileOwner).(string), d.Get(repoFileRepo).(string), path, gitea.DeleteFileOptions{
+		FileOptions: expandRepoFileOptions(d, fmt.Sprintf("Delete %s", path)),
+		SHA:         d.Get(repoFileSha).(string),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setRepoFileResourceData(file *gitea.FileResponse, d *schema.ResourceData) (err error) {
+	if file.Content != nil {
+		d.Set(repoFileSha, file.Content.SHA)
+	}
+	if file.Commit != nil {
+		d.Set(repoFileCommitSha, file.Commit.SHA)
+	}
+	return
+}
+
+func resourceGiteaRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceRepoFileRead,
+		Create: resourceRepoFileCreate,
+		Update: resourceRepoFileUpdate,
+		Delete: resourceRepoFileDelete,
+		Schema: map[string]*schema.Schema{
+			repoFileOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			repoFileRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			repoFilePath: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the file in the repository, without leading slash",
+			},
+			repoFileBranch: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "The branch the file is committed to. Defaults to the default branch of the repository",
+			},
+			repoFileContent: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The content of the file",
+			},
+			repoFileCommitMessage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The message of the commits created by terraform. Defaults to `Add <path>`, `Update <path>` or `Delete <path>`",
+			},
+			repoFileAuthorName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the commit author. Defaults to the authenticated user",
+			},
+			repoFileAuthorEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Email of the commit author. Defaults to the authenticated user",
+			},
+			repoFileCommitterName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the committer. Defaults to the author",
+			},
+			repoFileCommitterEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Email of the committer. Defaults to the author",
+			},
+			repoFileOverwriteOnCreate: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag if an already existing file should be overwritten instead of failing on creation",
+			},
+			repoFileSha: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blob SHA of the file",
+			},
+			repoFileCommitSha: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the last commit created by terraform",
+			},
+		},
+		Description: "`gitea_repository_file` manages a single file in a repository.\n\n" +
+			"Every change is committed to the configured branch. " +
+			"Changes to the file made outside of terraform are detected and reverted on the next apply.\n" +
+			"import is currently not supported",
+	}
+}
@@ -0,0 +1,244 @@
+package gitea
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	repoLabelOwner       string = "owner"
+	repoLabelRepo        string = "repo"
+	repoLabelName        string = "name"
+	repoLabelColor       string = "color"
+	repoLabelDescription string = "description"
+	repoLabelLabelId     string = "label_id"
+	repoLabelURL         string = "url"
+)
+
+var validLabelColor = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+
+func validateLabelColor(v interface{}, k string) (ws []string, errs []error) {
+	if !validLabelColor.MatchString(v.(string)) {
+		errs = append(errs, fmt.Errorf("%s must be a hex color like `#00aabb`, got %q", k, v.(string)))
+	}
+	return
+}
+
+// gitea reports colors without leading # and in lower case,
+// independent of how they were created
+func normalizeLabelColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(color, "#"))
+}
+
+func suppressLabelColorDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeLabelColor(old) == normalizeLabelColor(new)
+}
+
+func getAllRepoLabels(c *gitea.Client, owner string, repo string) (labels []*gitea.Label, err error) {
+	page := 1
+
+	for {
+		labelBuffer, _, err := c.ListRepoLabels(owner, repo, gitea.ListLabelsOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(labelBuffer) == 0 {
+			return labels, nil
+		}
+
+		labels = append(labels, labelBuffer...)
+
+		page += 1
+	}
+}
+
+func resourceRepoLabelIdParts(d *schema.ResourceData) (bool, string, string, int64, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return false, "", "", 0, nil
+	}
+
+	labelId, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return false, "", "", 0, err
+	}
+	return true, parts[0], parts[1], labelId, nil
+}
+
+func resourceRepoLabelRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, labelId, err := resourceRepoLabelIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid label id %q, expected owner/repo/id", d.Id())
+	}
+
+	label, resp, err := client.GetRepoLabel(owner, repo, labelId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setRepoLabelResourceData(owner, repo, label, d)
+
+	return
+}
+
+func resourceRepoLabelCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(repoLabelOwner).(string)
+	repo := d.Get(repoLabelRepo).(string)
+
+	label, _, err := client.CreateLabel(owner, repo, gitea.CreateLabelOption{
+		Name:        d.Get(repoLabelName).(string),
+		Color:       d.Get(repoLabelColor).(string),
+		Description: d.Get(repoLabelDescription).(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setRepoLabelResourceData(owner, repo, label, d)
+
+	return
+}
+
+func resourceRepoLabelUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, labelId, err := resourceRepoLabelIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid label id %q, expected owner/repo/id", d.Id())
+	}
+
+	var name string = d.Get(repoLabelName).(string)
+	var color string = d.Get(repoLabelColor).(string)
+	var description string = d.Get(repoLabelDescription).(string)
+
+	label, _, err := client.EditLabel(owner, repo, labelId, gitea.EditLabelOption{
+		Name:        &name,
+		Color:       &color,
+		Description: &description,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setRepoLabelResourceData(owner, repo, label, d)
+
+	return
+}
+
+func resourceRepoLabelDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, labelId, err := resourceRepoLabelIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteLabel(owner, repo, labelId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setRepoLabelResourceData(owner string, repo string, label *gitea.Label, d *schema.ResourceData) (err error) {
+	d.SetId(fmt.Sprintf("%s/%s/%d", owner, repo, label.ID))
+	d.Set(repoLabelOwner, owner)
+	d.Set(repoLabelRepo, repo)
+	d.Set(repoLabelName, label.Name)
+	d.Set(repoLabelColor, label.Color)
+	d.Set(repoLabelDescription, label.Description)
+	d.Set(repoLabelLabelId, label.ID)
+	d.Set(repoLabelURL, label.URL)
+	return
+}
+
+func resourceGiteaRepositoryLabel() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceRepoLabelRead,
+		Create: resourceRepoLabelCreate,
+		Update: resourceRepoLabelUpdate,
+		Delete: resourceRepoLabelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			repoLabelOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			repoLabelRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			repoLabelName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the label",
+			},
+			repoLabelColor: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateLabelColor,
+				DiffSuppressFunc: suppressLabelColorDiff,
+				Description:      "The color of the label as hex value, e.g. `#00aabb`",
+			},
+			repoLabelDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the label",
+			},
+			repoLabelLabelId: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The numeric ID of the label",
+			},
+			repoLabelURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Description: "`gitea_repository_label` manages a single issue label of a repository.\n\n" +
+			"Use `gitea_repository_labels` instead to manage all labels of a repository at once. " +
+			"Both resources should not be used for the same repository.\n\n" +
+			"Import is supported using the id `owner/repo/id`",
+	}
+}
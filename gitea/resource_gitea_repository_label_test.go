@@ -0,0 +1,46 @@
+package gitea
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+func TestGetAllRepoLabels_readsPagesSmallerThanRequested(t *testing.T) {
+	// the server limits every page to 10 labels, like a low MAX_RESPONSE_ITEMS would
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": "1.19.0"})
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/test/labels", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		labels := []map[string]interface{}{}
+		if page <= 3 {
+			for i := 0; i < 10; i++ {
+				id := (page-1)*10 + i + 1
+				labels = append(labels, map[string]interface{}{"id": id, "name": fmt.Sprintf("label-%d", id)})
+			}
+		}
+		json.NewEncoder(w).Encode(labels)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	labels, err := getAllRepoLabels(client, "lerentis", "test")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(labels) != 30 {
+		t.Errorf("Expected all 30 labels to be read, but got %d", len(labels))
+	}
+}
@@ -0,0 +1,245 @@
+package gitea
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	repoLabelsOwner string = "owner"
+	repoLabelsRepo  string = "repo"
+	repoLabelsLabel string = "label"
+)
+
+func resourceRepoLabelsIdParts(d *schema.ResourceData) (bool, string, string) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return false, "", ""
+	}
+	return true, parts[0], parts[1]
+}
+
+// hash labels on their normalized color, so `#00AABB` and `00aabb` are the same label
+func hashRepoLabel(v interface{}) int {
+	label := v.(map[string]interface{})
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", label[repoLabelName].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", normalizeLabelColor(label[repoLabelColor].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", label[repoLabelDescription].(string)))
+	return schema.HashString(buf.String())
+}
+
+func resourceRepoLabelsRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo := resourceRepoLabelsIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid repository labels id %q, expected owner/repo", d.Id())
+	}
+
+	_, resp, err := client.GetRepo(owner, repo)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	labels, err := getAllRepoLabels(client, owner, repo)
+	if err != nil {
+		return err
+	}
+
+	err = setRepoLabelsResourceData(owner, repo, labels, d)
+
+	return
+}
+
+func resourceRepoLabelsUpcreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(repoLabelsOwner).(string)
+	repo := d.Get(repoLabelsRepo).(string)
+
+	existing, err := getAllRepoLabels(client, owner, repo)
+	if err != nil {
+		return err
+	}
+	existingByName := make(map[string]*gitea.Label)
+	for _, label := range existing {
+		existingByName[label.Name] = label
+	}
+
+	declared := make(map[string]bool)
+	for _, l := range d.Get(repoLabelsLabel).(*schema.Set).List() {
+		label := l.(map[string]interface{})
+		var name string = label[repoLabelName].(string)
+		var color string = label[repoLabelColor].(string)
+		var description string = label[repoLabelDescription].(string)
+		declared[name] = true
+
+		current, ok := existingByName[name]
+		if !ok {
+			_, _, err = client.CreateLabel(owner, repo, gitea.CreateLabelOption{
+				Name:        name,
+				Color:       color,
+				Description: description,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if normalizeLabelColor(current.Color) != normalizeLabelColor(color) || current.Description != description {
+			_, _, err = client.EditLabel(owner, repo, current.ID, gitea.EditLabelOption{
+				Color:       &color,
+				Description: &description,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// labels not declared in terraform are removed
+	for _, label := range existing {
+		if declared[label.Name] {
+			continue
+		}
+		_, err = client.DeleteLabel(owner, repo, label.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repo))
+
+	return resourceRepoLabelsRead(d, meta)
+}
+
+func resourceRepoLabelsDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo := resourceRepoLabelsIdParts(d)
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	existing, err := getAllRepoLabels(client, owner, repo)
+	if err != nil {
+		return err
+	}
+
+	managed := make(map[string]bool)
+	for _, l := range d.Get(repoLabelsLabel).(*schema.Set).List() {
+		managed[l.(map[string]interface{})[repoLabelName].(string)] = true
+	}
+
+	for _, label := range existing {
+		if !managed[label.Name] {
+			continue
+		}
+		resp, err := client.DeleteLabel(owner, repo, label.ID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return err
+		}
+	}
+
+	return
+}
+
+func setRepoLabelsResourceData(owner string, repo string, labels []*gitea.Label, d *schema.ResourceData) (err error) {
+	// keep the color notation of the configuration to avoid needless diffs
+	configuredColors := make(map[string]string)
+	for _, l := range d.Get(repoLabelsLabel).(*schema.Set).List() {
+		label := l.(map[string]interface{})
+		configuredColors[label[repoLabelName].(string)] = label[repoLabelColor].(string)
+	}
+
+	labelList := make([]interface{}, 0, len(labels))
+	for _, label := range labels {
+		color := label.Color
+		if configured, ok := configuredColors[label.Name]; ok && normalizeLabelColor(configured) == normalizeLabelColor(color) {
+			color = configured
+		}
+		labelList = append(labelList, map[string]interface{}{
+			repoLabelName:        label.Name,
+			repoLabelColor:       color,
+			repoLabelDescription: label.Description,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repo))
+	d.Set(repoLabelsOwner, owner)
+	d.Set(repoLabelsRepo, repo)
+	err = d.Set(repoLabelsLabel, schema.NewSet(hashRepoLabel, labelList))
+
+	return
+}
+
+func resourceGiteaRepositoryLabels() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceRepoLabelsRead,
+		Create: resourceRepoLabelsUpcreate,
+		Update: resourceRepoLabelsUpcreate,
+		Delete: resourceRepoLabelsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			repoLabelsOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			repoLabelsRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			repoLabelsLabel: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashRepoLabel,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						repoLabelName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the label",
+						},
+						repoLabelColor: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLabelColor,
+							Description:  "The color of the label as hex value, e.g. `#00aabb`",
+						},
+						repoLabelDescription: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The description of the label",
+						},
+					},
+				},
+				Description: "The labels of the repository. Labels are matched by name",
+			},
+		},
+		Description: "`gitea_repository_labels` authoritatively manages all issue labels of a repository.\n\n" +
+			"Labels which are not declared are removed from the repository, including labels created from the `issue_labels` template. " +
+			"Destroying the resource removes all declared labels.\n" +
+			"This resource should not be used together with `gitea_repository_label` for the same repository.\n\n" +
+			"Import is supported using the id `owner/repo`",
+	}
+}
@@ -0,0 +1,303 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	milestoneOwner        string = "owner"
+	milestoneRepo         string = "repo"
+	milestoneTitle        string = "title"
+	milestoneDescription  string = "description"
+	milestoneDueDate      string = "due_date"
+	milestoneState        string = "state"
+	milestoneMilestoneId  string = "milestone_id"
+	milestoneOpenIssues   string = "open_issues"
+	milestoneClosedIssues string = "closed_issues"
+)
+
+func validateRFC3339(v interface{}, k string) (ws []string, errs []error) {
+	if v.(string) == "" {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a RFC3339 timestamp like `2023-03-31T23:59:59Z`, got %q", k, v.(string)))
+	}
+	return
+}
+
+// gitea moves every due date to the end of the day in the time zone of the server,
+// due dates on the same day in that time zone are equal
+func suppressSameDayDueDateDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	newTime = newTime.In(oldTime.Location())
+	return oldTime.Year() == newTime.Year() && oldTime.YearDay() == newTime.YearDay()
+}
+
+func resourceRepoMilestoneCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
+	oldDueDate, newDueDate := d.GetChange(milestoneDueDate)
+	if d.Id() != "" && oldDueDate.(string) != "" && newDueDate.(string) == "" {
+		return fmt.Errorf("the %s of a milestone can not be removed through the API once it is set", milestoneDueDate)
+	}
+	return nil
+}
+
+func expandMilestoneDueDate(d *schema.ResourceData) (*time.Time, error) {
+	dueDate := d.Get(milestoneDueDate).(string)
+	if dueDate == "" {
+		return nil, nil
+	}
+
+	deadline, err := time.Parse(time.RFC3339, dueDate)
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
+
+func resourceRepoMilestoneIdParts(d *schema.ResourceData) (bool, string, string, int64, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return false, "", "", 0, nil
+	}
+
+	milestoneId, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return false, "", "", 0, err
+	}
+	return true, parts[0], parts[1], milestoneId, nil
+}
+
+// milestones can be imported by owner/repo/id or by owner/repo/title
+func resourceRepoMilestoneImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*gitea.Client)
+
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid milestone id %q, expected owner/repo/id or owner/repo/title", d.Id())
+	}
+
+	if _, err := strconv.ParseInt(parts[2], 10, 64); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	milestone, _, err := client.GetMilestoneByName(parts[0], parts[1], parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", parts[0], parts[1], milestone.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRepoMilestoneRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, milestoneId, err := resourceRepoMilestoneIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid milestone id %q, expected owner/repo/id", d.Id())
+	}
+
+	milestone, resp, err := client.GetMilestone(owner, repo, milestoneId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setRepoMilestoneResourceData(owner, repo, milestone, d)
+
+	return
+}
+
+func resourceRepoMilestoneCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(milestoneOwner).(string)
+	repo := d.Get(milestoneRepo).(string)
+
+	deadline, err := expandMilestoneDueDate(d)
+	if err != nil {
+		return err
+	}
+
+	milestone, _, err := client.CreateMilestone(owner, repo, gitea.CreateMilestoneOption{
+		Title:       d.Get(milestoneTitle).(string),
+		Description: d.Get(milestoneDescription).(string),
+		State:       gitea.StateType(d.Get(milestoneState).(string)),
+		Deadline:    deadline,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setRepoMilestoneResourceData(owner, repo, milestone, d)
+
+	return
+}
+
+func resourceRepoMilestoneUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, milestoneId, err := resourceRepoMilestoneIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid milestone id %q, expected owner/repo/id", d.Id())
+	}
+
+	deadline, err := expandMilestoneDueDate(d)
+	if err != nil {
+		return err
+	}
+
+	var description string = d.Get(milestoneDescription).(string)
+	var state gitea.StateType = gitea.StateType(d.Get(milestoneState).(string))
+
+	milestone, _, err := client.EditMilestone(owner, repo, milestoneId, gitea.EditMilestoneOption{
+		Title:       d.Get(milestoneTitle).(string),
+		Description: &description,
+		State:       &state,
+		Deadline:    deadline,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setRepoMilestoneResourceData(owner, repo, milestone, d)
+
+	return
+}
+
+func resourceRepoMilestoneDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, milestoneId, err := resourceRepoMilestoneIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteMilestone(owner, repo, milestoneId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setRepoMilestoneResourceData(owner string, repo string, milestone *gitea.Milestone, d *schema.ResourceData) (err error) {
+	d.SetId(fmt.Sprintf("%s/%s/%d", owner, repo, milestone.ID))
+	d.Set(milestoneOwner, owner)
+	d.Set(milestoneRepo, repo)
+	d.Set(milestoneTitle, milestone.Title)
+	d.Set(milestoneDescription, milestone.Description)
+	d.Set(milestoneState, string(milestone.State))
+	d.Set(milestoneMilestoneId, milestone.ID)
+	d.Set(milestoneOpenIssues, milestone.OpenIssues)
+	d.Set(milestoneClosedIssues, milestone.ClosedIssues)
+	if milestone.Deadline != nil {
+		d.Set(milestoneDueDate, milestone.Deadline.Format(time.RFC3339))
+	} else {
+		d.Set(milestoneDueDate, "")
+	}
+	return
+}
+
+func resourceGiteaRepositoryMilestone() *schema.Resource {
+	return &schema.Resource{
+		Read:          resourceRepoMilestoneRead,
+		Create:        resourceRepoMilestoneCreate,
+		Update:        resourceRepoMilestoneUpdate,
+		Delete:        resourceRepoMilestoneDelete,
+		CustomizeDiff: resourceRepoMilestoneCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRepoMilestoneImport,
+		},
+		Schema: map[string]*schema.Schema{
+			milestoneOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			milestoneRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			milestoneTitle: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the milestone",
+			},
+			milestoneDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the milestone",
+			},
+			milestoneDueDate: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateFunc:     validateRFC3339,
+				DiffSuppressFunc: suppressSameDayDueDateDiff,
+				Description: "The due date of the milestone as RFC3339 timestamp, e.g. `2023-03-31T23:59:59Z`. " +
+					"gitea moves the due date to the end of the day, only the day is compared. " +
+					"Once set, the due date can only be changed but not removed again",
+			},
+			milestoneState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(gitea.StateOpen),
+				ValidateFunc: validateValueInList([]string{string(gitea.StateOpen), string(gitea.StateClosed)}),
+				Description:  "The state of the milestone, either `open` or `closed`",
+			},
+			milestoneMilestoneId: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The numeric ID of the milestone",
+			},
+			milestoneOpenIssues: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			milestoneClosedIssues: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Description: "`gitea_repository_milestone` manages a milestone of a repository.\n\n" +
+			"Import is supported using the id `owner/repo/id` or `owner/repo/title`",
+	}
+}
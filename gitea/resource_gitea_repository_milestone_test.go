@@ -0,0 +1,46 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSuppressSameDayDueDateDiff(t *testing.T) {
+	// the server reports the end of the day in its own time zone
+	old := "2023-03-31T23:59:59+02:00"
+
+	for configured, suppressed := range map[string]bool{
+		"2023-03-31T12:00:00Z":      true,
+		"2023-03-31T00:00:00+02:00": true,
+		"2023-03-30T23:00:00Z":      true,
+		"2023-03-31T23:00:00Z":      false,
+		"2023-04-01T12:00:00Z":      false,
+		"":                          false,
+	} {
+		if suppressSameDayDueDateDiff("due_date", old, configured, nil) != suppressed {
+			t.Errorf("Expected the diff from %q to %q to be suppressed: %t", old, configured, suppressed)
+		}
+	}
+}
+
+func TestResourceRepoMilestoneDiff_rejectsRemovedDueDate(t *testing.T) {
+	r := resourceGiteaRepositoryMilestone()
+	d := r.TestResourceData()
+	d.SetId("lerentis/test/1")
+	d.Set("owner", "lerentis")
+	d.Set("repo", "test")
+	d.Set("title", "v1.0.0")
+	d.Set("state", "open")
+	d.Set("due_date", "2023-03-31T23:59:59+02:00")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"owner": "lerentis",
+		"repo":  "test",
+		"title": "v1.0.0",
+	})
+	if _, err := r.Diff(context.Background(), d.State(), config, nil); err == nil {
+		t.Errorf("Expected the removal of the due date to be rejected")
+	}
+}
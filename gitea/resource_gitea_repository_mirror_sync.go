@@ -0,0 +1,117 @@
+package gitea
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	mirrorSyncOwner       string = "owner"
+	mirrorSyncRepo        string = "repo"
+	mirrorSyncTriggers    string = "triggers"
+	mirrorSyncRequestedAt string = "requested_at"
+)
+
+func resourceMirrorSyncIdParts(d *schema.ResourceData) (bool, string, string) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return false, "", ""
+	}
+	return true, parts[0], parts[1]
+}
+
+func resourceMirrorSyncRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo := resourceMirrorSyncIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid mirror sync id %q, expected owner/repo", d.Id())
+	}
+
+	_, resp, err := client.GetRepo(owner, repo)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	return
+}
+
+func resourceMirrorSyncCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(mirrorSyncOwner).(string)
+	repo := d.Get(mirrorSyncRepo).(string)
+
+	repository, _, err := client.GetRepo(owner, repo)
+	if err != nil {
+		return err
+	}
+	if !repository.Mirror {
+		return fmt.Errorf("%s/%s is not a mirror and can not be synced", owner, repo)
+	}
+
+	_, err = client.MirrorSync(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repo))
+	d.Set(mirrorSyncRequestedAt, time.Now().UTC().Format(time.RFC3339))
+
+	return
+}
+
+// nothing to clean up, the sync already happened
+func resourceMirrorSyncDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	d.SetId("")
+	return
+}
+
+func resourceGiteaRepositoryMirrorSync() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceMirrorSyncRead,
+		Create: resourceMirrorSyncCreate,
+		Delete: resourceMirrorSyncDelete,
+		Schema: map[string]*schema.Schema{
+			mirrorSyncOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the mirror",
+			},
+			mirrorSyncRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the mirror",
+			},
+			mirrorSyncTriggers: {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that trigger a new sync of the mirror whenever they change",
+			},
+			mirrorSyncRequestedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the last sync was requested",
+			},
+		},
+		Description: "`gitea_repository_mirror_sync` queues a sync of a mirrored repository.\n\n" +
+			"A sync is requested on creation and every time one of the `triggers` changes. " +
+			"The sync runs asynchronously on the gitea server, the resource does not wait for it to finish. " +
+			"Destroying the resource does nothing.",
+	}
+}
@@ -0,0 +1,159 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	repoTeamOwner           string = "owner"
+	repoTeamRepo            string = "repo"
+	repoTeamTeam            string = "team"
+	repoTeamTeamId          string = "team_id"
+	repoTeamIncludeAllRepos string = "include_all_repositories"
+)
+
+func resourceRepoTeamIdParts(d *schema.ResourceData) (bool, string, string, string) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return false, "", "", ""
+	}
+	return true, parts[0], parts[1], parts[2]
+}
+
+func resourceRepoTeamRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, teamName := resourceRepoTeamIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid repository team id %q, expected owner/repo/team", d.Id())
+	}
+
+	team, _, err := client.CheckRepoTeam(owner, repo, teamName)
+	if err != nil {
+		return err
+	}
+
+	// CheckRepoTeam returns no team if it is not assigned (anymore)
+	if team == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = setRepoTeamResourceData(owner, repo, team, d)
+
+	return
+}
+
+func resourceRepoTeamCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(repoTeamOwner).(string)
+	repo := d.Get(repoTeamRepo).(string)
+	teamName := d.Get(repoTeamTeam).(string)
+
+	team, _, err := client.CheckRepoTeam(owner, repo, teamName)
+	if err != nil {
+		return err
+	}
+
+	// Teams with include_all_repositories already have access to every repository
+	// of the organisation and gitea refuses to add the repository again
+	if team == nil {
+		_, err = client.AddRepoTeam(owner, repo, teamName)
+		if err != nil {
+			return err
+		}
+	} else {
+		tflog.Info(context.Background(), fmt.Sprintf("Team %s has already access to %s/%s, not adding it again", teamName, owner, repo))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, teamName))
+
+	return resourceRepoTeamRead(d, meta)
+}
+
+func resourceRepoTeamDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, teamName := resourceRepoTeamIdParts(d)
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get(repoTeamIncludeAllRepos).(bool) {
+		tflog.Warn(context.Background(), fmt.Sprintf("Team %s has access to all repositories of %s, access to %s can not be revoked", teamName, owner, repo))
+		return nil
+	}
+
+	resp, err := client.RemoveRepoTeam(owner, repo, teamName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setRepoTeamResourceData(owner string, repo string, team *gitea.Team, d *schema.ResourceData) (err error) {
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, team.Name))
+	d.Set(repoTeamOwner, owner)
+	d.Set(repoTeamRepo, repo)
+	d.Set(repoTeamTeam, team.Name)
+	d.Set(repoTeamTeamId, team.ID)
+	d.Set(repoTeamIncludeAllRepos, team.IncludesAllRepositories)
+	return
+}
+
+func resourceGiteaRepositoryTeam() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceRepoTeamRead,
+		Create: resourceRepoTeamCreate,
+		Delete: resourceRepoTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			repoTeamOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The organisation owning the repository",
+			},
+			repoTeamRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			repoTeamTeam: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the team that should get access to the repository",
+			},
+			repoTeamTeamId: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the team",
+			},
+			repoTeamIncludeAllRepos: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag if the team has access to all repositories of the organisation",
+			},
+		},
+		Description: "`gitea_repository_team` grants an existing team of an organisation access to a repository.\n\n" +
+			"If the team has `include_all_repositories` set, it already has access to the repository. " +
+			"In that case nothing is added on creation and nothing is removed on deletion.\n\n" +
+			"Import is supported using the id `owner/repo/team`",
+	}
+}
@@ -0,0 +1,432 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testGiteaClient returns a client talking to a fake gitea server
+// answering every path in responses with the JSON encoded value
+func testGiteaClient(t *testing.T, serverVersion string, responses map[string]interface{}) *gitea.Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": serverVersion})
+	})
+	for path, response := range responses {
+		response := response
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(response)
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return client
+}
+
+func testRepoResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                          1,
+		"owner":                       map[string]interface{}{"login": "lerentis"},
+		"name":                        "test",
+		"full_name":                   "lerentis/test",
+		"default_branch":              "main",
+		"permissions":                 map[string]interface{}{"admin": true, "push": true, "pull": true},
+		"has_issues":                  false,
+		"has_wiki":                    false,
+		"has_pull_requests":           false,
+		"has_projects":                false,
+		"ignore_whitespace_conflicts": false,
+		"allow_merge_commits":         false,
+		"allow_rebase":                false,
+		"allow_rebase_explicit":       false,
+		"allow_squash_merge":          true,
+		"archived":                    true,
+		"template":                    true,
+		"default_merge_style":         "squash",
+	}
+}
+
+func TestResourceRepoRead_detectsDrift(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repositories/1":             testRepoResponse(),
+		"/api/v1/repos/lerentis/test/topics": map[string]interface{}{"topics": []string{}},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepository().Schema, map[string]interface{}{
+		"username": "lerentis",
+		"name":     "test",
+	})
+	d.SetId("1")
+
+	if err := resourceRepoRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]bool{
+		"has_issues":                  false,
+		"has_wiki":                    false,
+		"has_pull_requests":           false,
+		"has_projects":                false,
+		"ignore_whitespace_conflicts": false,
+		"allow_merge_commits":         false,
+		"allow_rebase":                false,
+		"allow_rebase_explicit":       false,
+		"allow_squash_merge":          true,
+		"archived":                    true,
+		"repo_template":               true,
+	}
+	for attribute, value := range expected {
+		if d.Get(attribute).(bool) != value {
+			t.Errorf("Expected `%s` to be %t, but got %t", attribute, value, d.Get(attribute).(bool))
+		}
+	}
+	if v := d.Get("default_merge_style").(string); v != "squash" {
+		t.Errorf("Expected `default_merge_style` to be squash, but got %q", v)
+	}
+}
+
+func TestResourceRepoRead_keepsUnreportedFieldsOnOldServers(t *testing.T) {
+	client := testGiteaClient(t, "1.14.0", map[string]interface{}{
+		"/api/v1/repositories/1":             testRepoResponse(),
+		"/api/v1/repos/lerentis/test/topics": map[string]interface{}{"topics": []string{}},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepository().Schema, map[string]interface{}{
+		"username":     "lerentis",
+		"name":         "test",
+		"has_projects": true,
+	})
+	d.SetId("1")
+
+	if err := resourceRepoRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !d.Get("has_projects").(bool) {
+		t.Errorf("Expected `has_projects` to keep its configured value on servers not reporting it")
+	}
+	if d.Get("has_issues").(bool) {
+		t.Errorf("Expected `has_issues` to be read from the server")
+	}
+}
+
+func TestResourceRepoRead_readsTrackerSettings(t *testing.T) {
+	repo := testRepoResponse()
+	repo["has_issues"] = true
+	repo["has_wiki"] = true
+	repo["external_tracker"] = map[string]interface{}{
+		"external_tracker_url":    "https://jira.example.com",
+		"external_tracker_format": "https://jira.example.com/browse/{index}",
+		"external_tracker_style":  "alphanumeric",
+	}
+	repo["external_wiki"] = map[string]interface{}{
+		"external_wiki_url": "https://confluence.example.com",
+	}
+
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repositories/1":             repo,
+		"/api/v1/repos/lerentis/test/topics": map[string]interface{}{"topics": []string{}},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepository().Schema, map[string]interface{}{
+		"username": "lerentis",
+		"name":     "test",
+	})
+	d.SetId("1")
+
+	if err := resourceRepoRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v := d.Get("external_tracker.0.style").(string); v != "alphanumeric" {
+		t.Errorf("Expected external tracker style `alphanumeric`, but got %q", v)
+	}
+	if v := d.Get("external_wiki.0.url").(string); v != "https://confluence.example.com" {
+		t.Errorf("Expected external wiki url to be read, but got %q", v)
+	}
+	if v := d.Get("internal_tracker").([]interface{}); len(v) != 0 {
+		t.Errorf("Expected no internal tracker while an external tracker is used, but got %v", v)
+	}
+}
+
+func TestResourceRepoCreate_appliesEditOnlySettings(t *testing.T) {
+	var edit map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": "1.19.0"})
+	})
+	mux.HandleFunc("/api/v1/orgs/lerentis", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 2, "username": "lerentis"})
+	})
+	mux.HandleFunc("/api/v1/orgs/lerentis/repos", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(testRepoResponse())
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/test", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			json.NewDecoder(r.Body).Decode(&edit)
+		}
+		json.NewEncoder(w).Encode(testRepoResponse())
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/test/topics", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"topics": []string{}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepository().Schema, map[string]interface{}{
+		"username":            "lerentis",
+		"name":                "test",
+		"has_projects":        false,
+		"allow_rebase":        false,
+		"allow_squash_merge":  true,
+		"default_merge_style": "squash",
+	})
+	d.MarkNewResource()
+
+	if err := resourceRepoCreate(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if edit == nil {
+		t.Fatalf("Expected the settings of the new repository to be applied by editing it")
+	}
+	expected := map[string]interface{}{
+		"ignore_whitespace_conflicts": true,
+		"has_projects":                false,
+		"has_pull_requests":           true,
+		"allow_rebase":                false,
+		"allow_squash_merge":          true,
+		"default_merge_style":         "squash",
+	}
+	for attribute, value := range expected {
+		if edit[attribute] != value {
+			t.Errorf("Expected `%s` to be sent as %v, but got %v", attribute, value, edit[attribute])
+		}
+	}
+	if d.Id() != "1" {
+		t.Errorf("Expected the id of the new repository to be stored, but got %q", d.Id())
+	}
+}
+
+func TestResourceRepoDiff_rejectsChangesWithoutEffect(t *testing.T) {
+	r := resourceGiteaRepository()
+
+	d := r.TestResourceData()
+	d.SetId("1")
+	d.Set("username", "lerentis")
+	d.Set("name", "test")
+	for key, attribute := range r.Schema {
+		if attribute.Default != nil {
+			d.Set(key, attribute.Default)
+		}
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"username":                  "lerentis",
+		"name":                      "test",
+		"migration_mirror_interval": "1h0m0s",
+	})
+	if _, err := r.Diff(context.Background(), d.State(), config, nil); err == nil {
+		t.Errorf("Expected a change of `migration_mirror_interval` to be rejected for a repository which is no mirror")
+	}
+
+	for _, imported := range []bool{false, true} {
+		d.Set("imported", imported)
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"username":                     "lerentis",
+			"name":                         "test",
+			"migration_service_auth_token": "secret",
+		})
+		diff, err := r.Diff(context.Background(), d.State(), config, nil)
+		if err != nil {
+			t.Errorf("Expected changed migration credentials to be ignored, but got %s", err)
+		}
+		if diff != nil && diff.Attributes["migration_service_auth_token"] != nil {
+			t.Errorf("Expected no diff for changed migration credentials, but got %v", diff.Attributes["migration_service_auth_token"])
+		}
+	}
+}
+
+func TestResourceRepoUpdate_renamesAndTransfers(t *testing.T) {
+	var edited bool
+	var transfer map[string]interface{}
+
+	renamed := testRepoResponse()
+	renamed["name"] = "renamed"
+	renamed["full_name"] = "lerentis/renamed"
+	transferred := testRepoResponse()
+	transferred["owner"] = map[string]interface{}{"login": "neworg"}
+	transferred["name"] = "renamed"
+	transferred["full_name"] = "neworg/renamed"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": "1.19.0"})
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/test", func(w http.ResponseWriter, r *http.Request) {
+		edited = r.Method == http.MethodPatch
+		json.NewEncoder(w).Encode(renamed)
+	})
+	mux.HandleFunc("/api/v1/repos/lerentis/renamed/transfer", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&transfer)
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(transferred)
+	})
+	mux.HandleFunc("/api/v1/repos/neworg/renamed/topics", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"topics": []string{}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	r := resourceGiteaRepository()
+	d := r.TestResourceData()
+	d.SetId("1")
+	for key, attribute := range r.Schema {
+		if attribute.Default != nil {
+			d.Set(key, attribute.Default)
+		}
+	}
+	d.Set("username", "lerentis")
+	d.Set("name", "test")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"username": "neworg",
+		"name":     "renamed",
+	})
+	diff, err := r.Diff(context.Background(), d.State(), config, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state, diags := r.Apply(context.Background(), d.State(), diff, client)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	if !edited {
+		t.Errorf("Expected the repository to be renamed by editing it under its old name")
+	}
+	if transfer["new_owner"] != "neworg" {
+		t.Errorf("Expected the repository to be transferred to neworg, but got %v", transfer["new_owner"])
+	}
+	if state.Attributes["username"] != "neworg" || state.Attributes["name"] != "renamed" {
+		t.Errorf("Expected neworg/renamed in the state, but got %s/%s", state.Attributes["username"], state.Attributes["name"])
+	}
+}
+
+func TestResourceRepoImport_resolvesOwnerAndName(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test": testRepoResponse(),
+	})
+
+	for _, id := range []string{"lerentis/test", "1"} {
+		d := resourceGiteaRepository().TestResourceData()
+		d.SetId(id)
+
+		imported, err := resourceRepoImport(context.Background(), d, client)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if imported[0].Id() != "1" {
+			t.Errorf("Expected %q to be imported with id 1, but got %q", id, imported[0].Id())
+		}
+		if !imported[0].Get("auto_init").(bool) {
+			t.Errorf("Expected create only settings to be set to their defaults on import of %q", id)
+		}
+	}
+}
+
+func TestResourceRepoImport_doesNotReplaceMirrors(t *testing.T) {
+	repo := testRepoResponse()
+	repo["mirror"] = true
+	repo["mirror_interval"] = "8h0m0s"
+	repo["original_url"] = "https://github.com/lerentis/test.git"
+
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test":        repo,
+		"/api/v1/repositories/1":             repo,
+		"/api/v1/repos/lerentis/test/topics": map[string]interface{}{"topics": []string{}},
+	})
+
+	r := resourceGiteaRepository()
+	d := r.TestResourceData()
+	d.SetId("lerentis/test")
+
+	imported, err := resourceRepoImport(context.Background(), d, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := resourceRepoRead(imported[0], client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"username":                "lerentis",
+		"name":                    "test",
+		"mirror":                  true,
+		"migration_clone_address": "https://github.com/lerentis/test.git",
+		"migration_service":       "github",
+	})
+	diff, err := r.Diff(context.Background(), imported[0].State(), config, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff.RequiresNew() {
+		t.Errorf("Expected the imported mirror not to be replaced, but got %v", diff)
+	}
+}
+
+func TestResourceRepoImport_rejectsInvalidIds(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{})
+
+	d := resourceGiteaRepository().TestResourceData()
+	d.SetId("lerentis/test/extra")
+
+	if _, err := resourceRepoImport(context.Background(), d, client); err == nil {
+		t.Errorf("Expected an error for an invalid import id")
+	}
+}
+
+func TestResourceRepoDelete_refusesNonEmptyRepositories(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test": testRepoResponse(),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepository().Schema, map[string]interface{}{
+		"username":         "lerentis",
+		"name":             "test",
+		"destroy_behavior": "fail_if_not_empty",
+	})
+	d.SetId("1")
+
+	if err := respurceRepoDelete(d, client); err == nil {
+		t.Errorf("Expected the deletion of a repository with commits to fail")
+	}
+}
@@ -0,0 +1,410 @@
+package gitea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	webhookOwner        string = "owner"
+	webhookRepo         string = "repo"
+	webhookOrg          string = "org"
+	webhookType         string = "type"
+	webhookURL          string = "url"
+	webhookContentType  string = "content_type"
+	webhookHTTPMethod   string = "http_method"
+	webhookSecret       string = "secret"
+	webhookEvents       string = "events"
+	webhookBranchFilter string = "branch_filter"
+	webhookActive       string = "active"
+	webhookConfig       string = "config"
+	webhookCreated      string = "created"
+	webhookUpdated      string = "updated"
+)
+
+// webhookSubEvents lists the events gitea enables, and reports back, in place
+// of a configured parent event.
+var webhookSubEvents = map[string][]string{
+	"issues": {
+		"issue_assign",
+		"issue_label",
+		"issue_milestone",
+		"issue_comment",
+	},
+	"pull_request": {
+		"pull_request_assign",
+		"pull_request_label",
+		"pull_request_milestone",
+		"pull_request_comment",
+		"pull_request_review_approved",
+		"pull_request_review_rejected",
+		"pull_request_review_comment",
+		"pull_request_review_request",
+		"pull_request_sync",
+	},
+	"pull_request_review": {
+		"pull_request_review_approved",
+		"pull_request_review_rejected",
+		"pull_request_review_comment",
+	},
+}
+
+var webhookTypes = []string{
+	"gitea",
+	"gogs",
+	"slack",
+	"discord",
+	"dingtalk",
+	"telegram",
+	"msteams",
+	"feishu",
+	"matrix",
+	"wechatwork",
+}
+
+// config keys that are managed through dedicated attributes
+var webhookReservedConfigKeys = []string{
+	"url",
+	"content_type",
+	"http_method",
+	"secret",
+}
+
+func resourceRepoWebhookIdParts(d *schema.ResourceData) (bool, string, string, int64, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return false, "", "", 0, nil
+	}
+
+	hookId, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return false, "", "", 0, err
+	}
+	return true, parts[0], parts[1], hookId, nil
+}
+
+func expandWebhookConfig(d *schema.ResourceData) map[string]string {
+	config := make(map[string]string)
+	for k, v := range d.Get(webhookConfig).(map[string]interface{}) {
+		config[k] = v.(string)
+	}
+	config["url"] = d.Get(webhookURL).(string)
+	config["content_type"] = d.Get(webhookContentType).(string)
+	config["http_method"] = d.Get(webhookHTTPMethod).(string)
+	if d.Get(webhookSecret).(string) != "" {
+		config["secret"] = d.Get(webhookSecret).(string)
+	}
+	return config
+}
+
+func expandCreateHookOption(d *schema.ResourceData) gitea.CreateHookOption {
+	return gitea.CreateHookOption{
+		Type:         gitea.HookType(d.Get(webhookType).(string)),
+		Config:       expandWebhookConfig(d),
+		Events:       ExpandStringList(d.Get(webhookEvents).(*schema.Set).List()),
+		BranchFilter: d.Get(webhookBranchFilter).(string),
+		Active:       d.Get(webhookActive).(bool),
+	}
+}
+
+func expandEditHookOption(d *schema.ResourceData) gitea.EditHookOption {
+	var active bool = d.Get(webhookActive).(bool)
+
+	return gitea.EditHookOption{
+		Config:       expandWebhookConfig(d),
+		Events:       ExpandStringList(d.Get(webhookEvents).(*schema.Set).List()),
+		BranchFilter: d.Get(webhookBranchFilter).(string),
+		Active:       &active,
+	}
+}
+
+func validateWebhookConfig(value interface{}, key string) (ws []string, es []error) {
+	for k := range value.(map[string]interface{}) {
+		for _, reserved := range webhookReservedConfigKeys {
+			if k == reserved {
+				es = append(es, fmt.Errorf("%s must not contain %q, use the dedicated attribute instead", key, k))
+			}
+		}
+	}
+	return
+}
+
+// flattenWebhookEvents folds the sub events gitea reports for a configured
+// parent event back into the parent, sub events configured on their own are kept.
+func flattenWebhookEvents(reported []string, configured *schema.Set) []string {
+	has := make(map[string]bool)
+	for _, e := range reported {
+		has[e] = true
+	}
+
+	folded := make(map[string]bool)
+	for parent, subEvents := range webhookSubEvents {
+		if !configured.Contains(parent) {
+			continue
+		}
+		found := has[parent]
+		for _, e := range subEvents {
+			if has[e] {
+				found = true
+				if !configured.Contains(e) {
+					folded[e] = true
+				}
+			}
+		}
+		if found {
+			has[parent] = true
+		}
+	}
+
+	events := make([]string, 0, len(has))
+	for e := range has {
+		if !folded[e] {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func setWebhookResourceData(hook *gitea.Hook, d *schema.ResourceData) (err error) {
+	// The API does not report the secret of a hook, so it is kept as configured.
+	config := make(map[string]interface{})
+	for k := range d.Get(webhookConfig).(map[string]interface{}) {
+		if v, ok := hook.Config[k]; ok {
+			config[k] = v
+		}
+	}
+
+	for k, v := range map[string]interface{}{
+		webhookType:   hook.Type,
+		webhookEvents: schema.NewSet(schema.HashString, CollapseStringList(flattenWebhookEvents(hook.Events, d.Get(webhookEvents).(*schema.Set)))),
+		webhookActive: hook.Active,
+		webhookConfig: config,
+	} {
+		err = d.Set(k, v)
+		if err != nil {
+			return
+		}
+	}
+
+	// gitea treats an empty branch filter like "*"
+	if hook.BranchFilter == "" {
+		d.Set(webhookBranchFilter, "*")
+	} else {
+		d.Set(webhookBranchFilter, hook.BranchFilter)
+	}
+
+	if v, ok := hook.Config["url"]; ok {
+		d.Set(webhookURL, v)
+	}
+	if v, ok := hook.Config["content_type"]; ok {
+		d.Set(webhookContentType, v)
+	}
+	if v, ok := hook.Config["http_method"]; ok && v != "" {
+		d.Set(webhookHTTPMethod, strings.ToLower(v))
+	}
+	d.Set(webhookCreated, hook.Created.String())
+	d.Set(webhookUpdated, hook.Updated.String())
+
+	return
+}
+
+func resourceRepoWebhookRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, hookId, err := resourceRepoWebhookIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid webhook id %q, expected owner/repo/id", d.Id())
+	}
+
+	hook, resp, err := client.GetRepoHook(owner, repo, hookId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	d.Set(webhookOwner, owner)
+	d.Set(webhookRepo, repo)
+	err = setWebhookResourceData(hook, d)
+
+	return
+}
+
+func resourceRepoWebhookCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(webhookOwner).(string)
+	repo := d.Get(webhookRepo).(string)
+
+	hook, _, err := client.CreateRepoHook(owner, repo, expandCreateHookOption(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", owner, repo, hook.ID))
+	err = setWebhookResourceData(hook, d)
+
+	return
+}
+
+func resourceRepoWebhookUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, hookId, err := resourceRepoWebhookIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid webhook id %q, expected owner/repo/id", d.Id())
+	}
+
+	_, err = client.EditRepoHook(owner, repo, hookId, expandEditHookOption(d))
+	if err != nil {
+		return err
+	}
+
+	// EditRepoHook does not return the hook
+	return resourceRepoWebhookRead(d, meta)
+}
+
+func resourceRepoWebhookDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, hookId, err := resourceRepoWebhookIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteRepoHook(owner, repo, hookId)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+// webhookSchema returns the attributes shared by repository and organisation webhooks
+func webhookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		webhookType: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateValueInList(webhookTypes),
+			Description: "The type of the webhook.\n" +
+				"Can be `gitea`, `gogs`, `slack`, `discord`, `dingtalk`, `telegram`, `msteams`, `feishu`, `matrix` or `wechatwork`",
+		},
+		webhookURL: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The URL the webhook payload is delivered to",
+		},
+		webhookContentType: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "json",
+			ValidateFunc: validateValueInList([]string{"json", "form"}),
+			Description:  "The content type of the payload. Can be `json` or `form`",
+		},
+		webhookHTTPMethod: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "post",
+			ValidateFunc: validateValueInList([]string{"post", "get"}),
+			Description:  "The HTTP method used to deliver the payload. Can be `post` or `get`",
+		},
+		webhookSecret: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Default:     "",
+			Description: "Secret used to sign the payload",
+		},
+		webhookEvents: {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Required: true,
+			Description: "The events that trigger the webhook, e.g. `push`, `create`, `delete`, `pull_request`, `issues` or `release`.\n" +
+				"See the gitea documentation for the full list of events. " +
+				"The sub events gitea enables for a configured `issues`, `pull_request` or `pull_request_review` event are folded into it",
+		},
+		webhookBranchFilter: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+			Description: "Glob pattern of branches whose push, create and delete events trigger the webhook",
+		},
+		webhookActive: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Flag if the webhook should be active",
+		},
+		webhookConfig: {
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:     true,
+			ValidateFunc: validateWebhookConfig,
+			Description: "Additional configuration required by some webhook types, e.g. `channel` for `slack` " +
+				"or `homeserver_url`, `room_id` and `message_type` for `matrix`",
+		},
+		webhookCreated: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		webhookUpdated: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func resourceGiteaRepositoryWebhook() *schema.Resource {
+	s := webhookSchema()
+	s[webhookOwner] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The owner (user or organisation) of the repository",
+	}
+	s[webhookRepo] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the repository",
+	}
+
+	return &schema.Resource{
+		Read:   resourceRepoWebhookRead,
+		Create: resourceRepoWebhookCreate,
+		Update: resourceRepoWebhookUpdate,
+		Delete: resourceRepoWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: s,
+		Description: "`gitea_repository_webhook` manages a webhook of a repository.\n\n" +
+			"Import is supported using the id `owner/repo/id`",
+	}
+}
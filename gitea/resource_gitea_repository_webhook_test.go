@@ -0,0 +1,123 @@
+package gitea
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testHookResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":   3,
+		"type": "gitea",
+		"config": map[string]string{
+			"url":          "https://example.com/hook",
+			"content_type": "json",
+			"http_method":  "POST",
+		},
+		"events": []string{
+			"push",
+			"issues",
+			"issue_assign",
+			"issue_label",
+			"issue_milestone",
+			"issue_comment",
+			"pull_request",
+			"pull_request_assign",
+			"pull_request_sync",
+			"pull_request_review_approved",
+		},
+		"branch_filter": "main",
+		"active":        true,
+	}
+}
+
+func TestResourceRepoWebhookRead_foldsSubEvents(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test/hooks/3": testHookResponse(),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryWebhook().Schema, map[string]interface{}{
+		"owner":  "lerentis",
+		"repo":   "test",
+		"type":   "gitea",
+		"url":    "https://example.com/hook",
+		"events": []interface{}{"push", "pull_request", "issues", "issue_comment"},
+	})
+	d.SetId("lerentis/test/3")
+
+	if err := resourceRepoWebhookRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var events []string
+	for _, e := range d.Get("events").(*schema.Set).List() {
+		events = append(events, e.(string))
+	}
+	sort.Strings(events)
+	expected := []string{"issue_comment", "issues", "pull_request", "push"}
+	if len(events) != len(expected) {
+		t.Fatalf("Expected events %v, but got %v", expected, events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Fatalf("Expected events %v, but got %v", expected, events)
+		}
+	}
+}
+
+func TestResourceRepoWebhookRead_keepsUnconfiguredSubEvents(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test/hooks/3": testHookResponse(),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryWebhook().Schema, map[string]interface{}{
+		"owner":  "lerentis",
+		"repo":   "test",
+		"type":   "gitea",
+		"url":    "https://example.com/hook",
+		"events": []interface{}{"push"},
+	})
+	d.SetId("lerentis/test/3")
+
+	if err := resourceRepoWebhookRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	events := d.Get("events").(*schema.Set)
+	if !events.Contains("pull_request_sync") || !events.Contains("issue_assign") {
+		t.Errorf("Expected events not configured in terraform to show up, but got %v", events.List())
+	}
+}
+
+func TestResourceRepoWebhookRead_readsBranchFilter(t *testing.T) {
+	hook := testHookResponse()
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test/hooks/3": hook,
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaRepositoryWebhook().Schema, map[string]interface{}{
+		"owner":  "lerentis",
+		"repo":   "test",
+		"type":   "gitea",
+		"url":    "https://example.com/hook",
+		"events": []interface{}{"push"},
+	})
+	d.SetId("lerentis/test/3")
+
+	if err := resourceRepoWebhookRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := d.Get("branch_filter").(string); v != "main" {
+		t.Errorf("Expected `branch_filter` to be main, but got %q", v)
+	}
+
+	hook["branch_filter"] = ""
+	if err := resourceRepoWebhookRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := d.Get("branch_filter").(string); v != "*" {
+		t.Errorf("Expected an empty `branch_filter` to be read as *, but got %q", v)
+	}
+}
@@ -0,0 +1,238 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tagOwner      string = "owner"
+	tagRepo       string = "repo"
+	tagName       string = "name"
+	tagTarget     string = "target"
+	tagMessage    string = "message"
+	tagCommitSha  string = "commit_sha"
+	tagTagSha     string = "tag_sha"
+	tagAnnotated  string = "annotated"
+	tagZipballURL string = "zipball_url"
+	tagTarballURL string = "tarball_url"
+)
+
+func resourceTagIdParts(d *schema.ResourceData) (bool, string, string, string) {
+	// tag names may contain slashes, e.g. release/1.0
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return false, "", "", ""
+	}
+	return true, parts[0], parts[1], parts[2]
+}
+
+// The target can not be read back, imported tags start without one.
+// A changed target only recreates the tag if it points to another commit
+func resourceTagCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
+	if d.Id() == "" || !d.HasChange(tagTarget) {
+		return nil
+	}
+	if !d.NewValueKnown(tagTarget) {
+		return d.ForceNew(tagTarget)
+	}
+
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(tagOwner).(string)
+	repo := d.Get(tagRepo).(string)
+	target := d.Get(tagTarget).(string)
+
+	if target == "" {
+		repository, _, err := client.GetRepo(owner, repo)
+		if err != nil {
+			return err
+		}
+		target = repository.DefaultBranch
+	}
+
+	commit, resp, err := client.GetSingleCommit(owner, repo, target)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return fmt.Errorf("target %q of tag %s could not be found in %s/%s", target, d.Get(tagName).(string), owner, repo)
+		}
+		return err
+	}
+
+	if commit.SHA != d.Get(tagCommitSha).(string) {
+		return d.ForceNew(tagTarget)
+	}
+	return nil
+}
+
+func resourceTagRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, name := resourceTagIdParts(d)
+	if !hasId {
+		return fmt.Errorf("invalid tag id %q, expected owner/repo/tag", d.Id())
+	}
+
+	tag, resp, err := client.GetTag(owner, repo, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	err = setTagResourceData(owner, repo, tag, d)
+
+	return
+}
+
+func resourceTagCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	owner := d.Get(tagOwner).(string)
+	repo := d.Get(tagRepo).(string)
+
+	tag, _, err := client.CreateTag(owner, repo, gitea.CreateTagOption{
+		TagName: d.Get(tagName).(string),
+		Target:  d.Get(tagTarget).(string),
+		Message: d.Get(tagMessage).(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setTagResourceData(owner, repo, tag, d)
+
+	return
+}
+
+// only the target can change without recreating the tag, see resourceTagCustomizeDiff
+func resourceTagUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	return resourceTagRead(d, meta)
+}
+
+func resourceTagDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, owner, repo, name := resourceTagIdParts(d)
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.DeleteTag(owner, repo, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func setTagResourceData(owner string, repo string, tag *gitea.Tag, d *schema.ResourceData) (err error) {
+	var commitSha string
+	if tag.Commit != nil {
+		commitSha = tag.Commit.SHA
+	}
+
+	// lightweight tags point directly to the commit and report the commit message as message
+	annotated := tag.ID != commitSha
+	if annotated && strings.TrimSpace(tag.Message) != strings.TrimSpace(d.Get(tagMessage).(string)) {
+		d.Set(tagMessage, tag.Message)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, tag.Name))
+	d.Set(tagOwner, owner)
+	d.Set(tagRepo, repo)
+	d.Set(tagName, tag.Name)
+	d.Set(tagCommitSha, commitSha)
+	d.Set(tagTagSha, tag.ID)
+	d.Set(tagAnnotated, annotated)
+	d.Set(tagZipballURL, tag.ZipballURL)
+	d.Set(tagTarballURL, tag.TarballURL)
+	return
+}
+
+func resourceGiteaTag() *schema.Resource {
+	return &schema.Resource{
+		Read:          resourceTagRead,
+		Create:        resourceTagCreate,
+		Update:        resourceTagUpdate,
+		Delete:        resourceTagDelete,
+		CustomizeDiff: resourceTagCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			tagOwner: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The owner (user or organisation) of the repository",
+			},
+			tagRepo: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository",
+			},
+			tagName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the tag",
+			},
+			tagTarget: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: "The branch, tag or commit SHA the tag points to. Defaults to the default branch of the repository.\n" +
+					"A changed target recreates the tag, unless it resolves to the commit the tag already points to",
+			},
+			tagMessage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "The message of the tag. If set, an annotated tag is created instead of a lightweight tag",
+			},
+			tagCommitSha: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit the tag points to",
+			},
+			tagTagSha: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the tag object. Equal to `commit_sha` for lightweight tags",
+			},
+			tagAnnotated: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag if the tag is an annotated tag",
+			},
+			tagZipballURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagTarballURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Description: "`gitea_tag` manages a git tag of a repository.\n\n" +
+			"Tags can not be moved, every change that moves the tag to another commit recreates it. " +
+			"Tags which are referenced by a release can not be deleted. " +
+			"Requires gitea 1.15.0 or newer.\n\n" +
+			"Import is supported using the id `owner/repo/tag`. " +
+			"Imported tags have no `target`, configuring the branch, tag or commit they point to does not recreate them",
+	}
+}
@@ -0,0 +1,43 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceTagDiff_onlyRecreatesMovedTags(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/repos/lerentis/test/git/commits/main":    map[string]interface{}{"sha": "1234"},
+		"/api/v1/repos/lerentis/test/git/commits/develop": map[string]interface{}{"sha": "5678"},
+	})
+
+	r := resourceGiteaTag()
+	d := r.TestResourceData()
+	d.SetId("lerentis/test/v1.0.0")
+	d.Set("owner", "lerentis")
+	d.Set("repo", "test")
+	d.Set("name", "v1.0.0")
+	d.Set("commit_sha", "1234")
+	d.Set("tag_sha", "1234")
+
+	for target, requiresNew := range map[string]bool{
+		"main":    false,
+		"develop": true,
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"owner":  "lerentis",
+			"repo":   "test",
+			"name":   "v1.0.0",
+			"target": target,
+		})
+		diff, err := r.Diff(context.Background(), d.State(), config, client)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if diff.RequiresNew() != requiresNew {
+			t.Errorf("Expected target %q to require a new tag: %t, but got %t", target, requiresNew, diff.RequiresNew())
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package gitea
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -18,10 +19,85 @@ const (
 	TeamCreateRepoFlag      string = "can_create_repos"
 	TeamIncludeAllReposFlag string = "include_all_repositories"
 	TeamUnits               string = "units"
+	TeamUnitsMap            string = "units_map"
 	TeamMembers             string = "members"
 	TeamRepositories        string = "repositories"
+	TeamIgnoreMembers       string = "ignore_members"
 )
 
+var teamUnitTypes = []string{
+	string(gitea.RepoUnitCode),
+	string(gitea.RepoUnitIssues),
+	string(gitea.RepoUnitExtIssues),
+	string(gitea.RepoUnitWiki),
+	string(gitea.RepoUnitPulls),
+	string(gitea.RepoUnitReleases),
+	string(gitea.RepoUnitProjects),
+	string(gitea.RepoUnitExtWiki),
+}
+
+var teamUnitAccessModes = []string{
+	string(gitea.AccessModeNone),
+	string(gitea.AccessModeRead),
+	string(gitea.AccessModeWrite),
+	string(gitea.AccessModeAdmin),
+}
+
+func validateTeamUnitsMap(value interface{}, key string) (ws []string, es []error) {
+	for unit, mode := range value.(map[string]interface{}) {
+		_, unitErrs := validateValueInList(teamUnitTypes)(unit, key+" unit")
+		_, modeErrs := validateValueInList(teamUnitAccessModes)(mode, key+"."+unit)
+		es = append(es, unitErrs...)
+		es = append(es, modeErrs...)
+	}
+	return
+}
+
+// expandTeamUnits returns the configured units, or all units if none are configured
+func expandTeamUnits(d *schema.ResourceData) (units []gitea.RepoUnitType) {
+	configured := d.Get(TeamUnits).(*schema.Set).List()
+	if len(configured) == 0 {
+		for _, unit := range teamUnitTypes {
+			units = append(units, gitea.RepoUnitType(unit))
+		}
+		return
+	}
+
+	for _, unit := range configured {
+		units = append(units, gitea.RepoUnitType(unit.(string)))
+	}
+	return
+}
+
+func expandTeamUnitsMap(d *schema.ResourceData, meta interface{}) (map[string]string, error) {
+	client := meta.(*gitea.Client)
+
+	// The server reports the units map of every team, it is only sent if it is configured.
+	// Otherwise it would take precedence over changes to the units
+	if !isConfigured(d, TeamUnitsMap) {
+		return nil, nil
+	}
+	configured := d.Get(TeamUnitsMap).(map[string]interface{})
+
+	if err := client.CheckServerVersionConstraint(">= 1.17.0"); err != nil {
+		return nil, fmt.Errorf("%s requires gitea 1.17.0 or newer: %s", TeamUnitsMap, err)
+	}
+
+	unitsMap := make(map[string]string)
+	for unit, mode := range configured {
+		unitsMap[unit] = mode.(string)
+	}
+	return unitsMap, nil
+}
+
+func flattenTeamUnits(units []gitea.RepoUnitType) *schema.Set {
+	var result []string
+	for _, unit := range units {
+		result = append(result, string(unit))
+	}
+	return schema.NewSet(schema.HashString, CollapseStringList(result))
+}
+
 func resourceTeamRead(d *schema.ResourceData, meta interface{}) (err error) {
 	client := meta.(*gitea.Client)
 
@@ -46,35 +122,50 @@ func resourceTeamRead(d *schema.ResourceData, meta interface{}) (err error) {
 	return
 }
 
+func resourceTeamImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*gitea.Client)
+
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err != nil {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid team id %q, expected the numeric id or organisation/team_name", d.Id())
+		}
+
+		teams, err := getAllOrgTeams(client, parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		var team *gitea.Team
+		for _, t := range teams {
+			if t.Name == parts[1] {
+				team = t
+				break
+			}
+		}
+		if team == nil {
+			return nil, fmt.Errorf("team %s could not be found in organisation %s", parts[1], parts[0])
+		}
+
+		d.SetId(fmt.Sprintf("%d", team.ID))
+		// gitea versions before 1.19.4 do not return the organisation of a team
+		d.Set(TeamOrg, parts[0])
+	}
+
+	d.Set(TeamIgnoreMembers, false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTeamCreate(d *schema.ResourceData, meta interface{}) (err error) {
 	client := meta.(*gitea.Client)
 
 	var team *gitea.Team
-	var units []gitea.RepoUnitType
 
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.code") {
-		units = append(units, gitea.RepoUnitCode)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.issues") {
-		units = append(units, gitea.RepoUnitIssues)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.ext_issues") {
-		units = append(units, gitea.RepoUnitExtIssues)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.wiki") {
-		units = append(units, gitea.RepoUnitWiki)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.pulls") {
-		units = append(units, gitea.RepoUnitPulls)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.releases") {
-		units = append(units, gitea.RepoUnitReleases)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.ext_wiki") {
-		units = append(units, gitea.RepoUnitExtWiki)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.projects") {
-		units = append(units, gitea.RepoUnitProjects)
+	units := expandTeamUnits(d)
+	unitsMap, err := expandTeamUnitsMap(d, meta)
+	if err != nil {
+		return err
 	}
 
 	includeAllRepos := d.Get(TeamIncludeAllReposFlag).(bool)
@@ -86,6 +177,7 @@ func resourceTeamCreate(d *schema.ResourceData, meta interface{}) (err error) {
 		CanCreateOrgRepo:        d.Get(TeamCreateRepoFlag).(bool),
 		IncludesAllRepositories: includeAllRepos,
 		Units:                   units,
+		UnitsMap:                unitsMap,
 	}
 
 	team, _, err = client.CreateTeam(d.Get(TeamOrg).(string), opts)
@@ -94,19 +186,15 @@ func resourceTeamCreate(d *schema.ResourceData, meta interface{}) (err error) {
 		return
 	}
 
-	users := d.Get(TeamMembers).([]interface{})
-
-	for _, user := range users {
-		if user != "" {
-			_, err = client.AddTeamMember(team.ID, user.(string))
-			if err != nil {
-				return err
-			}
+	if !d.Get(TeamIgnoreMembers).(bool) {
+		err = setTeamMembers(team, d, meta)
+		if err != nil {
+			return err
 		}
 	}
 
 	if !includeAllRepos {
-		err = setTeamRepositories(team, d, meta, false)
+		err = setTeamRepositories(team, d, meta)
 		if err != nil {
 			return err
 		}
@@ -128,8 +216,8 @@ func resourceTeamUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 	team, resp, err = client.GetTeam(id)
 
 	if err != nil {
-		if resp.StatusCode == 404 {
-			resourceTeamCreate(d, meta)
+		if resp != nil && resp.StatusCode == 404 {
+			return resourceTeamCreate(d, meta)
 		} else {
 			return err
 		}
@@ -139,31 +227,10 @@ func resourceTeamUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 	canCreateRepo := d.Get(TeamCreateRepoFlag).(bool)
 	includeAllRepos := d.Get(TeamIncludeAllReposFlag).(bool)
 
-	var units []gitea.RepoUnitType
-
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.code") {
-		units = append(units, gitea.RepoUnitCode)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.issues") {
-		units = append(units, gitea.RepoUnitIssues)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.ext_issues") {
-		units = append(units, gitea.RepoUnitExtIssues)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.wiki") {
-		units = append(units, gitea.RepoUnitWiki)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.pulls") {
-		units = append(units, gitea.RepoUnitPulls)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.releases") {
-		units = append(units, gitea.RepoUnitReleases)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.ext_wiki") {
-		units = append(units, gitea.RepoUnitExtWiki)
-	}
-	if strings.Contains(d.Get(TeamUnits).(string), "repo.projects") {
-		units = append(units, gitea.RepoUnitProjects)
+	units := expandTeamUnits(d)
+	unitsMap, err := expandTeamUnitsMap(d, meta)
+	if err != nil {
+		return err
 	}
 
 	opts := gitea.EditTeamOption{
@@ -173,6 +240,7 @@ func resourceTeamUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 		CanCreateOrgRepo:        &canCreateRepo,
 		IncludesAllRepositories: &includeAllRepos,
 		Units:                   units,
+		UnitsMap:                unitsMap,
 	}
 
 	resp, err = client.EditTeam(id, opts)
@@ -181,19 +249,15 @@ func resourceTeamUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 		return err
 	}
 
-	users := d.Get(TeamMembers).([]interface{})
-
-	for _, user := range users {
-		if user != "" {
-			_, err = client.AddTeamMember(team.ID, user.(string))
-			if err != nil {
-				return err
-			}
+	if !d.Get(TeamIgnoreMembers).(bool) && d.HasChange(TeamMembers) {
+		err = setTeamMembers(team, d, meta)
+		if err != nil {
+			return err
 		}
 	}
 
-	if !includeAllRepos {
-		err = setTeamRepositories(team, d, meta, true)
+	if !includeAllRepos && d.HasChanges(TeamRepositories, TeamIncludeAllReposFlag) {
+		err = setTeamRepositories(team, d, meta)
 		if err != nil {
 			return err
 		}
@@ -239,9 +303,31 @@ func setTeamResourceData(team *gitea.Team, d *schema.ResourceData, meta interfac
 	d.Set(TeamName, team.Name)
 	d.Set(TeamPermissions, string(team.Permission))
 	d.Set(TeamIncludeAllReposFlag, team.IncludesAllRepositories)
-	d.Set(TeamUnits, d.Get(TeamUnits).(string))
-	d.Set(TeamMembers, d.Get(TeamMembers))
-	d.Set(TeamRepositories, d.Get(TeamRepositories))
+	d.Set(TeamUnits, flattenTeamUnits(team.Units))
+	if team.UnitsMap != nil {
+		d.Set(TeamUnitsMap, team.UnitsMap)
+	}
+
+	// Members managed with gitea_team_membership are not tracked by the team
+	if d.Get(TeamIgnoreMembers).(bool) {
+		d.Set(TeamMembers, nil)
+	} else {
+		members, err := getAllTeamMembers(client, team.ID)
+		if err != nil {
+			return err
+		}
+		d.Set(TeamMembers, schema.NewSet(schema.HashString, CollapseStringList(members)))
+	}
+
+	// Teams including all repositories always have every repository of the organisation
+	if !team.IncludesAllRepositories {
+		repositories, err := getAllTeamRepositories(client, team.ID)
+		if err != nil {
+			return err
+		}
+		d.Set(TeamRepositories, schema.NewSet(schema.HashString, CollapseStringList(repositories)))
+	}
+
 	return
 }
 
@@ -252,7 +338,15 @@ func resourceGiteaTeam() *schema.Resource {
 		Update: resourceTeamUpdate,
 		Delete: resourceTeamDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTeamImport,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceGiteaTeamV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceTeamStateUpgradeV0,
+			},
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -297,81 +391,189 @@ func resourceGiteaTeam() *schema.Resource {
 				Description: "Flag if the Teams members should have access to all Repositories in the Organisation",
 			},
 			"units": {
-				Type:     schema.TypeString,
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateValueInList(teamUnitTypes),
+				},
 				Required: false,
 				Optional: true,
-				Default:  "[repo.code, repo.issues, repo.ext_issues, repo.wiki, repo.pulls, repo.releases, repo.projects, repo.ext_wiki]",
-				Description: "List of types of Repositories that should be allowed to be created from Team members.\n" +
+				Computed: true,
+				Description: "Set of repository units the Team members have access to. Defaults to all units.\n" +
 					"Can be `repo.code`, `repo.issues`, `repo.ext_issues`, `repo.wiki`, `repo.pulls`, `repo.releases`, `repo.projects` and/or `repo.ext_wiki`",
 			},
+			"units_map": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:     false,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTeamUnitsMap,
+				Description: "Access mode per repository unit, e.g. `{ \"repo.code\" = \"read\", \"repo.issues\" = \"write\" }`.\n" +
+					"Access modes can be `none`, `read`, `write` or `admin`. Requires gitea 1.17.0 or newer.\n" +
+					"Takes precedence over `units` if it is set, otherwise the access modes are derived from `units` and `permission`",
+			},
 			"members": {
-				Type: schema.TypeList,
+				Type: schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional:    true,
-				Required:    false,
-				Computed:    true,
-				Description: "List of Users that should be part of this team",
+				Optional:      true,
+				Required:      false,
+				Computed:      true,
+				ConflictsWith: []string{"ignore_members"},
+				Description: "Set of Users that should be part of this team.\n" +
+					"Users not in the set are removed from the team",
+			},
+			"ignore_members": {
+				Type:     schema.TypeBool,
+				Required: false,
+				Optional: true,
+				Default:  false,
+				Description: "Flag if the members of the team should not be managed at all.\n" +
+					"Use it when the members are managed with `gitea_team_membership`",
 			},
 			"repositories": {
-				Type: schema.TypeList,
+				Type: schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional:    true,
-				Required:    false,
-				Computed:    true,
-				Description: "List of Repositories that should be part of this team",
+				Optional: true,
+				Required: false,
+				Computed: true,
+				Description: "Set of Repositories that should be part of this team.\n" +
+					"Repositories not in the set are removed from the team. Ignored if `include_all_repositories` is set",
 			},
 		},
-		Description: "`gitea_team` manages Team that are part of an organisation.",
+		Description: "`gitea_team` manages Team that are part of an organisation.\n\n" +
+			"`members` and `repositories` are authoritative once they are set: " +
+			"every user and repository not listed is removed from the team. " +
+			"If they are not set, the members and repositories of the team are not managed.\n\n" +
+			"Import is supported using the numeric id of the team or `organisation/team_name`. " +
+			"Use `organisation/team_name` for gitea versions before 1.19.4, they do not return the organisation of a team",
 	}
 }
 
-func setTeamRepositories(team *gitea.Team, d *schema.ResourceData, meta interface{}, update bool) (err error) {
+func getAllTeamMembers(c *gitea.Client, id int64) (members []string, err error) {
+	page := 1
+
+	for {
+		users, _, err := c.ListTeamMembers(id, gitea.ListTeamMembersOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("[ERROR] Error listing team members: %s", err))
+		}
+
+		if len(users) == 0 {
+			return members, nil
+		}
+
+		for _, user := range users {
+			members = append(members, user.UserName)
+		}
+
+		page += 1
+	}
+}
+
+func getAllTeamRepositories(c *gitea.Client, id int64) (repositories []string, err error) {
+	page := 1
+
+	for {
+		repos, _, err := c.ListTeamRepositories(id, gitea.ListTeamRepositoriesOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50,
+			},
+		})
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("[ERROR] Error listing team repositories: %s", err))
+		}
+
+		if len(repos) == 0 {
+			return repositories, nil
+		}
+
+		for _, repo := range repos {
+			repositories = append(repositories, repo.Name)
+		}
+
+		page += 1
+	}
+}
+
+// setTeamMembers adds all configured members to the team and removes everybody else
+func setTeamMembers(team *gitea.Team, d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	members := make(map[string]bool)
+	for _, user := range d.Get(TeamMembers).(*schema.Set).List() {
+		if user != "" {
+			members[user.(string)] = true
+		}
+	}
+
+	existingMembers, err := getAllTeamMembers(client, team.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range existingMembers {
+		if _, exists := members[user]; exists {
+			members[user] = false
+		} else {
+			_, err = client.RemoveTeamMember(team.ID, user)
+			if err != nil {
+				return errors.New(fmt.Sprintf("[ERROR] Error removing team member %q: %s", user, err))
+			}
+		}
+	}
+
+	for user, flag := range members {
+		if flag {
+			_, err = client.AddTeamMember(team.ID, user)
+			if err != nil {
+				return errors.New(fmt.Sprintf("[ERROR] Error adding team member %q: %s", user, err))
+			}
+		}
+	}
+
+	return
+}
+
+// setTeamRepositories adds all configured repositories to the team and removes all others
+func setTeamRepositories(team *gitea.Team, d *schema.ResourceData, meta interface{}) (err error) {
 	client := meta.(*gitea.Client)
 
 	org := d.Get(TeamOrg).(string)
 
 	repositories := make(map[string]bool)
-	for _, repo := range d.Get(TeamRepositories).([]interface{}) {
+	for _, repo := range d.Get(TeamRepositories).(*schema.Set).List() {
 		if repo != "" {
 			repositories[repo.(string)] = true
 		}
 	}
 
-	if update {
-		page := 1
+	// all repositories are listed before removing any, removals would shift the pages
+	existingRepositories, err := getAllTeamRepositories(client, team.ID)
+	if err != nil {
+		return err
+	}
 
-		for {
-			var existingRepositories []*gitea.Repository
-			existingRepositories, _, err = client.ListTeamRepositories(team.ID, gitea.ListTeamRepositoriesOptions{
-				ListOptions: gitea.ListOptions{
-					Page:     page,
-					PageSize: 50,
-				},
-			})
+	for _, repo := range existingRepositories {
+		if _, exists := repositories[repo]; exists {
+			repositories[repo] = false
+		} else {
+			_, err = client.RemoveTeamRepository(team.ID, org, repo)
 			if err != nil {
-				return errors.New(fmt.Sprintf("[ERROR] Error listeng team repositories: %s", err))
-			}
-			if len(existingRepositories) == 0 {
-				break
+				return errors.New(fmt.Sprintf("[ERROR] Error removing team repository %q: %s", repo, err))
 			}
-
-			for _, exr := range existingRepositories {
-				_, exists := repositories[exr.Name]
-				if exists {
-					repositories[exr.Name] = false
-				} else {
-					_, err = client.RemoveTeamRepository(team.ID, org, exr.Name)
-					if err != nil {
-						return errors.New(fmt.Sprintf("[ERROR] Error removing team repository %q: %s", exr.Name, err))
-					}
-				}
-			}
-
-			page += 1
 		}
 	}
 
@@ -386,3 +588,76 @@ func setTeamRepositories(team *gitea.Team, d *schema.ResourceData, meta interfac
 
 	return
 }
+
+// resourceGiteaTeamV0 is the schema of gitea_team before units became a set
+func resourceGiteaTeamV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"organisation": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"can_create_repos": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_all_repositories": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"units": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"members": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Computed: true,
+			},
+			"repositories": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceTeamStateUpgradeV0 converts the units string like
+// `[repo.code, repo.issues]` into a list of unit names,
+// matching the units the string was interpreted as before
+func resourceTeamStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	units, _ := rawState["units"].(string)
+
+	var upgraded []interface{}
+	for _, unit := range teamUnitTypes {
+		if strings.Contains(units, unit) {
+			upgraded = append(upgraded, unit)
+		}
+	}
+	rawState["units"] = upgraded
+
+	return rawState, nil
+}
@@ -0,0 +1,122 @@
+package gitea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	teamMembershipTeamId   string = "team_id"
+	teamMembershipUsername string = "username"
+)
+
+func resourceTeamMembershipIdParts(d *schema.ResourceData) (bool, int64, string, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[1] == "" {
+		return false, 0, "", nil
+	}
+
+	teamId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return false, 0, "", err
+	}
+	return true, teamId, parts[1], nil
+}
+
+func resourceTeamMembershipRead(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, teamId, username, err := resourceTeamMembershipIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		return fmt.Errorf("invalid team membership id %q, expected team_id/username", d.Id())
+	}
+
+	user, resp, err := client.GetTeamMember(teamId, username)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	d.Set(teamMembershipTeamId, teamId)
+	d.Set(teamMembershipUsername, user.UserName)
+
+	return
+}
+
+func resourceTeamMembershipCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	teamId := int64(d.Get(teamMembershipTeamId).(int))
+	username := d.Get(teamMembershipUsername).(string)
+
+	_, err = client.AddTeamMember(teamId, username)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", teamId, username))
+
+	return resourceTeamMembershipRead(d, meta)
+}
+
+func resourceTeamMembershipDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	client := meta.(*gitea.Client)
+
+	hasId, teamId, username, err := resourceTeamMembershipIdParts(d)
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.RemoveTeamMember(teamId, username)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return
+}
+
+func resourceGiteaTeamMembership() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourceTeamMembershipRead,
+		Create: resourceTeamMembershipCreate,
+		Delete: resourceTeamMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			teamMembershipTeamId: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the team",
+			},
+			teamMembershipUsername: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the user that should be a member of the team",
+			},
+		},
+		Description: "`gitea_team_membership` adds a single user to a team without managing the other members.\n\n" +
+			"Set `ignore_members` on the `gitea_team` when its members are managed with this resource.\n\n" +
+			"Import is supported using the id `team_id/username`",
+	}
+}
@@ -0,0 +1,318 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testTeamServer is a fake gitea server for the team with id 5 of the organisation lerentis,
+// it keeps track of the members and repositories of the team and of every edit
+type testTeamServer struct {
+	team         map[string]interface{}
+	members      map[string]bool
+	repositories map[string]bool
+	edits        []map[string]interface{}
+}
+
+func newTestTeamServer(t *testing.T, team map[string]interface{}, members []string, repositories []string) (*testTeamServer, *gitea.Client) {
+	s := &testTeamServer{
+		team:         team,
+		members:      make(map[string]bool),
+		repositories: make(map[string]bool),
+	}
+	for _, member := range members {
+		s.members[member] = true
+	}
+	for _, repo := range repositories {
+		s.repositories[repo] = true
+	}
+
+	// only the first page has entries, the following ones are empty
+	listPage := func(w http.ResponseWriter, r *http.Request, entries map[string]bool, key string) {
+		result := []map[string]interface{}{}
+		if r.URL.Query().Get("page") == "1" {
+			var names []string
+			for name := range entries {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				result = append(result, map[string]interface{}{key: name})
+			}
+		}
+		json.NewEncoder(w).Encode(result)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": "1.19.0"})
+	})
+	mux.HandleFunc("/api/v1/teams/5", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			edit := make(map[string]interface{})
+			json.NewDecoder(r.Body).Decode(&edit)
+			s.edits = append(s.edits, edit)
+		}
+		json.NewEncoder(w).Encode(s.team)
+	})
+	mux.HandleFunc("/api/v1/teams/5/members", func(w http.ResponseWriter, r *http.Request) {
+		listPage(w, r, s.members, "login")
+	})
+	mux.HandleFunc("/api/v1/teams/5/members/", func(w http.ResponseWriter, r *http.Request) {
+		member := strings.TrimPrefix(r.URL.Path, "/api/v1/teams/5/members/")
+		if r.Method == http.MethodPut {
+			s.members[member] = true
+		} else if r.Method == http.MethodDelete {
+			delete(s.members, member)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/teams/5/repos", func(w http.ResponseWriter, r *http.Request) {
+		listPage(w, r, s.repositories, "name")
+	})
+	mux.HandleFunc("/api/v1/teams/5/repos/lerentis/", func(w http.ResponseWriter, r *http.Request) {
+		repo := strings.TrimPrefix(r.URL.Path, "/api/v1/teams/5/repos/lerentis/")
+		if r.Method == http.MethodPut {
+			s.repositories[repo] = true
+		} else if r.Method == http.MethodDelete {
+			delete(s.repositories, repo)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return s, client
+}
+
+func testTeamResponse() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                        5,
+		"name":                      "developers",
+		"permission":                "write",
+		"can_create_org_repo":       true,
+		"includes_all_repositories": true,
+		"units":                     []string{"repo.code", "repo.issues"},
+		"units_map": map[string]string{
+			"repo.code":   "write",
+			"repo.issues": "write",
+		},
+	}
+}
+
+// testRawConfig returns the raw configuration of a resource, attributes which are not given are null
+func testRawConfig(r *schema.Resource, values map[string]cty.Value) cty.Value {
+	attributes := make(map[string]cty.Value)
+	for name, attributeType := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else {
+			attributes[name] = cty.NullVal(attributeType)
+		}
+	}
+	return cty.ObjectVal(attributes)
+}
+
+func TestResourceTeamStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name":         "developers",
+		"organisation": "lerentis",
+		"units":        "[repo.code, repo.ext_issues, repo.pulls, repo.typo]",
+	}
+
+	upgraded, err := resourceTeamStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []interface{}{"repo.code", "repo.ext_issues", "repo.pulls"}
+	if !reflect.DeepEqual(upgraded["units"], expected) {
+		t.Errorf("Expected units %v, but got %v", expected, upgraded["units"])
+	}
+	if upgraded["name"] != "developers" {
+		t.Errorf("Expected other attributes to be kept, but got name %v", upgraded["name"])
+	}
+}
+
+func TestValidateTeamUnitsMap(t *testing.T) {
+	_, errs := validateTeamUnitsMap(map[string]interface{}{
+		"repo.code":   "read",
+		"repo.issues": "write",
+	}, "units_map")
+	if len(errs) != 0 {
+		t.Errorf("Expected valid units map, but got %v", errs)
+	}
+
+	_, errs = validateTeamUnitsMap(map[string]interface{}{
+		"repo.cod":    "read",
+		"repo.issues": "owner",
+	}, "units_map")
+	if len(errs) != 2 {
+		t.Errorf("Expected 2 errors for an invalid unit and access mode, but got %v", errs)
+	}
+}
+
+func TestResourceTeamImport_resolvesOrganisationAndName(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"version": "1.19.0"})
+	})
+	mux.HandleFunc("/api/v1/orgs/lerentis/teams", func(w http.ResponseWriter, r *http.Request) {
+		teams := []map[string]interface{}{}
+		if r.URL.Query().Get("page") == "1" {
+			teams = append(teams,
+				map[string]interface{}{"id": 3, "name": "Owners"},
+				map[string]interface{}{"id": 7, "name": "developers"},
+			)
+		}
+		json.NewEncoder(w).Encode(teams)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitea.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceGiteaTeam().TestResourceData()
+	d.SetId("lerentis/developers")
+
+	if _, err := resourceTeamImport(context.Background(), d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if d.Id() != "7" {
+		t.Errorf("Expected id 7, got %s", d.Id())
+	}
+	if org := d.Get("organisation").(string); org != "lerentis" {
+		t.Errorf("Expected organisation lerentis, got %s", org)
+	}
+
+	d.SetId("lerentis/missing")
+	if _, err := resourceTeamImport(context.Background(), d, client); err == nil {
+		t.Errorf("Expected an error for a team that does not exist")
+	}
+}
+
+func TestResourceTeamUpdate_sendsUnitsWithoutReportedUnitsMap(t *testing.T) {
+	server, client := newTestTeamServer(t, testTeamResponse(), nil, nil)
+
+	r := resourceGiteaTeam()
+	d := r.TestResourceData()
+	d.SetId("5")
+	d.Set("organisation", "lerentis")
+	if err := resourceTeamRead(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(d.Get("units_map").(map[string]interface{})) != 2 {
+		t.Fatalf("Expected the units map reported by the server to be read")
+	}
+
+	config := map[string]interface{}{
+		"name":         "developers",
+		"organisation": "lerentis",
+		"permission":   "write",
+		"units":        []interface{}{"repo.code"},
+	}
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	diff.RawConfig = testRawConfig(r, map[string]cty.Value{
+		"name":         cty.StringVal("developers"),
+		"organisation": cty.StringVal("lerentis"),
+		"permission":   cty.StringVal("write"),
+		"units":        cty.SetVal([]cty.Value{cty.StringVal("repo.code")}),
+	})
+
+	if _, diags := r.Apply(context.Background(), d.State(), diff, client); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	if len(server.edits) != 1 {
+		t.Fatalf("Expected the team to be edited once, but got %d edits", len(server.edits))
+	}
+	edit := server.edits[0]
+	if unitsMap, ok := edit["units_map"]; ok && unitsMap != nil {
+		t.Errorf("Expected no units map to be sent if it is not configured, but got %v", unitsMap)
+	}
+	if !reflect.DeepEqual(edit["units"], []interface{}{"repo.code"}) {
+		t.Errorf("Expected the configured units to be sent, but got %v", edit["units"])
+	}
+}
+
+func TestSetTeamMembers_addsAndRemovesMembers(t *testing.T) {
+	server, client := newTestTeamServer(t, testTeamResponse(), []string{"alice", "bob"}, nil)
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaTeam().Schema, map[string]interface{}{
+		"name":         "developers",
+		"organisation": "lerentis",
+		"members":      []interface{}{"bob", "carol"},
+	})
+
+	if err := setTeamMembers(&gitea.Team{ID: 5}, d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]bool{"bob": true, "carol": true}
+	if !reflect.DeepEqual(server.members, expected) {
+		t.Errorf("Expected the members %v, but got %v", expected, server.members)
+	}
+}
+
+func TestSetTeamRepositories_addsAndRemovesRepositories(t *testing.T) {
+	server, client := newTestTeamServer(t, testTeamResponse(), nil, []string{"app", "docs"})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaTeam().Schema, map[string]interface{}{
+		"name":                     "developers",
+		"organisation":             "lerentis",
+		"include_all_repositories": false,
+		"repositories":             []interface{}{"docs", "infra"},
+	})
+
+	if err := setTeamRepositories(&gitea.Team{ID: 5}, d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]bool{"docs": true, "infra": true}
+	if !reflect.DeepEqual(server.repositories, expected) {
+		t.Errorf("Expected the repositories %v, but got %v", expected, server.repositories)
+	}
+}
+
+func TestResourceTeamUpdate_recreatesMissingTeams(t *testing.T) {
+	client := testGiteaClient(t, "1.19.0", map[string]interface{}{
+		"/api/v1/orgs/lerentis/teams": testTeamResponse(),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGiteaTeam().Schema, map[string]interface{}{
+		"name":           "developers",
+		"organisation":   "lerentis",
+		"permission":     "write",
+		"ignore_members": true,
+	})
+	d.SetId("3")
+
+	if err := resourceTeamUpdate(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if d.Id() != "5" {
+		t.Errorf("Expected the missing team to be created again with id 5, but got %q", d.Id())
+	}
+}